@@ -1,23 +1,76 @@
 package servlet
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 )
 
 // App interface used to define the methods of a servlet application.
 type App struct {
-	container *AppContainer
-	providers []AppProvider
-	boot      bool
+	container       *AppContainer
+	events          *AppEventDispatcher
+	supervisor      *AppSupervisor
+	providers       []AppProvider
+	registry        *AppProviderRegistry
+	configID        string
+	boot            bool
+	shutdownTimeout time.Duration
+	warmUp          bool
+	warmUpTags      []string
 }
 
 // NewApp used to instantiate a new application.
 func NewApp() *App {
-	return &App{
-		container: NewAppContainer(),
-		providers: []AppProvider{},
-		boot:      false,
+	a := &App{
+		container:       NewAppContainer(),
+		events:          NewAppEventDispatcher(),
+		providers:       []AppProvider{},
+		registry:        NewAppProviderRegistry(),
+		configID:        ContainerConfigID,
+		boot:            false,
+		shutdownTimeout: AppShutdownTimeout,
+		warmUp:          AppWarmUp,
+		warmUpTags:      []string{},
 	}
+
+	if env := os.Getenv(EnvAppShutdownTimeout); env != "" {
+		seconds, _ := strconv.Atoi(env)
+		a.shutdownTimeout = time.Second * time.Duration(seconds)
+	}
+
+	if env := os.Getenv(EnvContainerConfigID); env != "" {
+		a.configID = env
+	}
+
+	if env := os.Getenv(EnvAppWarmUp); env != "" {
+		a.warmUp = env == "true"
+	}
+
+	if env := os.Getenv(EnvAppWarmUpTags); env != "" {
+		for _, tag := range strings.Split(env, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				a.warmUpTags = append(a.warmUpTags, tag)
+			}
+		}
+	}
+
+	a.supervisor, _ = NewAppSupervisor(a.container)
+
+	_ = a.container.Add(ContainerAppEventDispatcherID, func(*AppContainer) (interface{}, error) {
+		return a.events, nil
+	})
+
+	_ = a.container.Add(ContainerAppSupervisorID, func(*AppContainer) (interface{}, error) {
+		return a.supervisor, nil
+	})
+
+	return a
 }
 
 // Container will retrieve the application underlying container.
@@ -25,8 +78,55 @@ func (a App) Container() *AppContainer {
 	return a.container
 }
 
-// Add will register a new provider into the application used
-// on the application boot.
+// Events will retrieve the application event dispatcher.
+func (a App) Events() *AppEventDispatcher {
+	return a.events
+}
+
+// Supervisor will retrieve the application background runners supervisor.
+func (a App) Supervisor() *AppSupervisor {
+	return a.supervisor
+}
+
+// Registry will retrieve the registry of the provider constructors used to
+// instantiate the providers enabled by the application configuration.
+func (a App) Registry() *AppProviderRegistry {
+	return a.registry
+}
+
+// ShutdownTimeout will retrieve the maximum amount of time that the
+// application will wait for the termination of the services on shutdown.
+func (a App) ShutdownTimeout() time.Duration {
+	return a.shutdownTimeout
+}
+
+// SetShutdownTimeout will define the maximum amount of time that the
+// application will wait for the termination of the services on shutdown.
+func (a *App) SetShutdownTimeout(timeout time.Duration) {
+	if a == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	a.shutdownTimeout = timeout
+}
+
+// SetWarmUp will define if the application should instantiate the
+// container objects right after the boot of the providers, so any object
+// misconfiguration is reported by the boot process instead of the first
+// object request. If a list of tags is given, only the objects registered
+// with any of the given tags will be instantiated.
+func (a *App) SetWarmUp(enabled bool, tags ...string) {
+	if a == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	a.warmUp = enabled
+	a.warmUpTags = append([]string{}, tags...)
+}
+
+// Add will store a new provider into the application that will be
+// registered and booted on the application boot. If the application is
+// already booted, the provider is registered and booted on the spot.
 func (a *App) Add(provider AppProvider) error {
 	if a == nil {
 		panic(fmt.Errorf("nil pointer receiver"))
@@ -36,32 +136,372 @@ func (a *App) Add(provider AppProvider) error {
 		return fmt.Errorf("invalid nil 'provider' argument")
 	}
 
-	a.providers = append(a.providers, provider)
-	if err := provider.Register(a.container); err != nil {
-		a.providers = a.providers[:len(a.providers)-1]
-		return err
+	if a.boot {
+		if err := provider.Register(a.container); err != nil {
+			return fmt.Errorf("provider '%T' register error : %w", provider, err)
+		}
+		_ = a.events.Dispatch(AppEvent{Name: AppEventProviderRegistered, Data: provider})
+
+		if err := a.check([]AppProvider{provider}); err != nil {
+			return err
+		}
+
+		if err := provider.Boot(a.container); err != nil {
+			return fmt.Errorf("provider '%T' boot error : %w", provider, err)
+		}
 	}
 
+	a.providers = append(a.providers, provider)
 	return nil
 }
 
+// AddRunner will register a background runner into the application
+// supervisor. The runners are started after the application boot and
+// cancelled when the application is shutting down.
+func (a *App) AddRunner(id string, runner AppRunner, policy AppRunnerPolicy) error {
+	if a == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	return a.supervisor.Add(id, runner, policy)
+}
+
 // Boot initialize the application if not initialized yet.
 // The initialization of an application is the calling of the register method
 // on all providers, after the registration of all objects in the container,
 // the boot method of all providers will be executed.
+// The providers are registered and booted in the order that satisfies the
+// dependencies declared by the providers that implement the
+// AppProviderDependent interface, keeping the order in which the providers
+// were added when no dependency exists between them. A required id that no
+// provider declares to provide is satisfied if registered in the container
+// by any provider.
+// After the boot of the added providers, if the application container holds
+// a configuration with a list of providers, those providers are instantiated
+// from the provider registry and also registered and booted.
+// Each list entry can be the name of the provider constructor or a partial
+// with the constructor name and the provider parameters sub-tree.
+//
+//	providers:
+//	  - servlet.service
+//	  - name: servlet.log
+//	    params:
+//	      logger_id: log
+//
+// If the warm-up mode is enabled, the container objects are instantiated
+// after the boot of all the providers.
+// If a provider fails to register or boot, or the warm-up fails, the
+// providers that were already booted are shut down in the reverse order and
+// the container is closed.
+// After the boot of all the providers, the supervised runners are started.
 func (a *App) Boot() error {
 	if a == nil {
 		panic(fmt.Errorf("nil pointer receiver"))
 	}
 
 	if !a.boot {
-		for _, p := range a.providers {
-			if err := p.Boot(a.container); err != nil {
-				return err
+		providers, err := a.sort(a.providers)
+		if err != nil {
+			return a.rollback(nil, err)
+		}
+
+		if err := a.registerProviders(nil, providers); err != nil {
+			return err
+		}
+
+		if err := a.check(providers); err != nil {
+			return a.rollback(nil, err)
+		}
+
+		_ = a.events.Dispatch(AppEvent{Name: AppEventBooting})
+		if providers, err = a.bootProviders(nil, providers); err != nil {
+			return err
+		}
+
+		configured, err := a.configured()
+		if err != nil {
+			return a.rollback(providers, fmt.Errorf("application providers configuration error : %w", err))
+		}
+
+		if configured, err = a.sort(configured); err != nil {
+			return a.rollback(providers, fmt.Errorf("application providers configuration error : %w", err))
+		}
+
+		if err := a.registerProviders(providers, configured); err != nil {
+			return err
+		}
+
+		if err := a.check(configured); err != nil {
+			return a.rollback(providers, fmt.Errorf("application providers configuration error : %w", err))
+		}
+
+		if providers, err = a.bootProviders(providers, configured); err != nil {
+			return err
+		}
+
+		if a.warmUp {
+			if err := a.container.WarmUp(a.warmUpTags...); err != nil {
+				return a.rollback(providers, fmt.Errorf("application warm-up error : %w", err))
 			}
 		}
 
+		a.providers = providers
 		a.boot = true
+		a.supervisor.Start()
+		_ = a.events.Dispatch(AppEvent{Name: AppEventBooted})
 	}
 	return nil
 }
+
+// Run will boot the application and block the execution until a
+// termination signal (SIGINT or SIGTERM) is received or the given context
+// is cancelled. After that, the application will be shut down, waiting
+// at most the defined shutdown timeout for the process to terminate.
+func (a *App) Run(ctx context.Context) error {
+	if a == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	if ctx == nil {
+		return fmt.Errorf("invalid nil 'ctx' argument")
+	}
+
+	if err := a.Boot(); err != nil {
+		return err
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	select {
+	case <-signals:
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), a.shutdownTimeout)
+	defer cancel()
+
+	return a.Shutdown(shutdownCtx)
+}
+
+// Shutdown will terminate the application by stopping the supervised
+// runners, calling the shutdown method of all the booted providers that
+// implement the AppProviderShutdown interface, in the reverse order of their
+// registration, and closing the application container afterwards. If the
+// given context is done prior the termination of the process, the method
+// will return with the context error.
+func (a *App) Shutdown(ctx context.Context) error {
+	if a == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	if ctx == nil {
+		return fmt.Errorf("invalid nil 'ctx' argument")
+	}
+
+	var providers []AppProvider
+	if a.boot {
+		providers = a.providers
+		a.boot = false
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_ = a.events.Dispatch(AppEvent{Name: AppEventShutdownRequested})
+		a.supervisor.Stop()
+		errs := a.unwind(ctx, providers)
+		if err := a.container.Close(); err != nil {
+			errs = append(errs, err)
+		}
+		_ = a.events.Dispatch(AppEvent{Name: AppEventClosed})
+		done <- errs.errorOrNil()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("application shutdown interrupted : %w", ctx.Err())
+	}
+}
+
+func (a *App) registerProviders(booted, providers []AppProvider) error {
+	for _, p := range providers {
+		if err := p.Register(a.container); err != nil {
+			return a.rollback(booted, fmt.Errorf("provider '%T' register error : %w", p, err))
+		}
+		_ = a.events.Dispatch(AppEvent{Name: AppEventProviderRegistered, Data: p})
+	}
+	return nil
+}
+
+func (a *App) bootProviders(booted, providers []AppProvider) ([]AppProvider, error) {
+	for _, p := range providers {
+		if err := p.Boot(a.container); err != nil {
+			return nil, a.rollback(booted, fmt.Errorf("provider '%T' boot error : %w", p, err))
+		}
+		booted = append(booted, p)
+	}
+	return booted, nil
+}
+
+func (a *App) configured() (providers []AppProvider, err error) {
+	if !a.container.Has(a.configID) {
+		return nil, nil
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			providers, err = nil, panicError(r)
+		}
+	}()
+
+	config, err := a.container.Get(a.configID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := config.(*Config).Get(AppProvidersConfigPath)
+	if entries == nil {
+		return nil, nil
+	}
+
+	for _, entry := range entries.([]interface{}) {
+		var name string
+		var params ConfigPartial
+		switch def := entry.(type) {
+		case string:
+			name = def
+		default:
+			name = def.(ConfigPartial).String("name")
+			params = def.(ConfigPartial).Config("params", ConfigPartial{})
+		}
+
+		provider, err := a.registry.Create(name, params)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
+	}
+	return providers, nil
+}
+
+func (a *App) rollback(providers []AppProvider, err error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), a.shutdownTimeout)
+	defer cancel()
+
+	errs := append(AppErrors{err}, a.unwind(ctx, providers)...)
+	if err := a.container.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	_ = a.events.Dispatch(AppEvent{Name: AppEventClosed})
+
+	if len(errs) == 1 {
+		return err
+	}
+	return errs
+}
+
+func (a *App) unwind(ctx context.Context, providers []AppProvider) AppErrors {
+	errs := AppErrors{}
+	for i := len(providers) - 1; i >= 0; i-- {
+		if p, ok := providers[i].(AppProviderShutdown); ok {
+			if err := p.Shutdown(ctx, a.container); err != nil {
+				errs = append(errs, fmt.Errorf("provider '%T' shutdown error : %w", p, err))
+			}
+		}
+	}
+	return errs
+}
+
+func (a App) suppliers(providers []AppProvider) map[string][]int {
+	suppliers := map[string][]int{}
+	for i, p := range providers {
+		if dependent, ok := p.(AppProviderDependent); ok {
+			for _, id := range dependent.Provides() {
+				suppliers[id] = append(suppliers[id], i)
+			}
+		}
+	}
+	return suppliers
+}
+
+// check will verify, after the registration of the given providers, that
+// all the ids they require without a declared supplier were registered in
+// the container.
+func (a App) check(providers []AppProvider) error {
+	suppliers := a.suppliers(providers)
+	for _, p := range providers {
+		if dependent, ok := p.(AppProviderDependent); ok {
+			for _, id := range dependent.Requires() {
+				if _, ok := suppliers[id]; !ok && !a.container.Has(id) {
+					return fmt.Errorf("provider '%T' requires the unavailable '%s' dependency", p, id)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (a App) sort(providers []AppProvider) ([]AppProvider, error) {
+	suppliers := a.suppliers(providers)
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	sorted := make([]AppProvider, 0, len(providers))
+	states := make([]int, len(providers))
+	var path []int
+	var links []string
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch states[i] {
+		case visited:
+			return nil
+		case visiting:
+			steps := []string{fmt.Sprintf("%T", providers[i])}
+			for n := len(path) - 1; n >= 0; n-- {
+				steps = append([]string{fmt.Sprintf("%T", providers[path[n]]), fmt.Sprintf("-[%s]->", links[n])}, steps...)
+				if path[n] == i {
+					break
+				}
+			}
+			return fmt.Errorf("provider dependency cycle : %s", strings.Join(steps, " "))
+		}
+
+		states[i] = visiting
+		path = append(path, i)
+
+		if dependent, ok := providers[i].(AppProviderDependent); ok {
+			for _, id := range dependent.Requires() {
+				links = append(links, id)
+				for _, j := range suppliers[id] {
+					if j == i {
+						continue
+					}
+					if err := visit(j); err != nil {
+						return err
+					}
+				}
+				links = links[:len(links)-1]
+			}
+		}
+
+		path = path[:len(path)-1]
+		states[i] = visited
+		sorted = append(sorted, providers[i])
+		return nil
+	}
+
+	for i := range providers {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
@@ -0,0 +1,95 @@
+package servlet
+
+import (
+	"time"
+)
+
+const (
+	// AppShutdownTimeout defines the default maximum amount of time that
+	// the application will wait for the termination of all the services
+	// when shutting down.
+	AppShutdownTimeout = time.Second * 30
+
+	// EnvAppShutdownTimeout defines the name of the environment variable
+	// to be checked for a overriding value for the application shutdown
+	// timeout (in seconds).
+	EnvAppShutdownTimeout = "SERVLET_APP_SHUTDOWN_TIMEOUT"
+
+	// AppWarmUp defines the default flag used to signal the application to
+	// instantiate the container objects right after the providers boot.
+	AppWarmUp = false
+
+	// EnvAppWarmUp defines the name of the environment variable to be
+	// checked for a overriding value for the application warm-up flag.
+	EnvAppWarmUp = "SERVLET_APP_WARM_UP"
+
+	// EnvAppWarmUpTags defines the name of the environment variable to be
+	// checked for a comma separated list of tags used to restrict the
+	// container objects instantiated on the application warm-up.
+	EnvAppWarmUpTags = "SERVLET_APP_WARM_UP_TAGS"
+
+	// AppRunnerMinBackoff defines the minimum delay between the termination
+	// of a supervised runner and its restart, so a runner that terminates
+	// immediately is not restarted in a tight loop.
+	AppRunnerMinBackoff = 10 * time.Millisecond
+
+	// AppProvidersConfigPath defines the configuration path of the list of
+	// providers to be instantiated and booted by the application after the
+	// boot of the providers added to the application.
+	AppProvidersConfigPath = "providers"
+
+	// AppProviderFileSystem defines the name used to reference the servlet
+	// file system provider in the application providers configuration.
+	AppProviderFileSystem = "servlet.filesystem"
+
+	// AppProviderLog defines the name used to reference the servlet log
+	// provider in the application providers configuration.
+	AppProviderLog = "servlet.log"
+
+	// AppProviderService defines the name used to reference the servlet
+	// service provider in the application providers configuration.
+	AppProviderService = "servlet.service"
+
+	// AppProviderTrigger defines the name used to reference the servlet
+	// trigger provider in the application providers configuration.
+	AppProviderTrigger = "servlet.trigger"
+
+	// AppContainerInjectTag defines the name of the struct field tag used
+	// to define the container object to be assigned to the field by the
+	// container Inject method.
+	AppContainerInjectTag = "servlet"
+
+	// ContainerAppEventDispatcherID defines the id used to register the
+	// application event dispatcher in the application container.
+	ContainerAppEventDispatcherID = "servlet.app.events"
+
+	// ContainerAppSupervisorID defines the id used to register the
+	// application background runners supervisor in the application
+	// container.
+	ContainerAppSupervisorID = "servlet.app.supervisor"
+
+	// AppEventAll defines the event name used to subscribe all the events
+	// dispatched by the application event dispatcher.
+	AppEventAll = "*"
+
+	// AppEventProviderRegistered defines the name of the event dispatched
+	// after the registration of a provider. The event data will hold the
+	// registered provider.
+	AppEventProviderRegistered = "servlet.app.provider.registered"
+
+	// AppEventBooting defines the name of the event dispatched prior the
+	// boot of the application providers.
+	AppEventBooting = "servlet.app.booting"
+
+	// AppEventBooted defines the name of the event dispatched after the
+	// boot of all the application providers.
+	AppEventBooted = "servlet.app.booted"
+
+	// AppEventShutdownRequested defines the name of the event dispatched
+	// when the application shutdown process starts.
+	AppEventShutdownRequested = "servlet.app.shutdown"
+
+	// AppEventClosed defines the name of the event dispatched after the
+	// termination of the application shutdown process.
+	AppEventClosed = "servlet.app.closed"
+)
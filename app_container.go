@@ -1,82 +1,529 @@
 package servlet
 
-import "fmt"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type appContainerLoad struct {
+	done    chan struct{}
+	entry   interface{}
+	err     error
+	parent  *appContainerLoad
+	child   *appContainerLoad
+	waiting *appContainerLoad
+}
+
+type appContainerOverride struct {
+	registered   bool
+	factory      AppContainerFactory
+	lifetime     AppContainerLifetime
+	decorators   []AppContainerDecorator
+	instantiated bool
+	entry        interface{}
+	dependencies []string
+}
+
+type appContainerScope struct {
+	mutex        sync.Locker
+	waits        sync.Locker
+	parent       *appContainerScope
+	factories    map[string]AppContainerFactory
+	lifetimes    map[string]AppContainerLifetime
+	types        map[string]reflect.Type
+	bindings     map[reflect.Type]string
+	decorators   map[string][]AppContainerDecorator
+	aliases      map[string]string
+	tags         map[string][]string
+	entries      map[string]interface{}
+	order        []string
+	dependencies map[string][]string
+	loading      map[string]*appContainerLoad
+	overrides    map[string][]*appContainerOverride
+}
+
+func newAppContainerScope(parent *appContainerScope) *appContainerScope {
+	// the links between the in-flight loads, used to detect the dependency
+	// cycles between concurrent instantiations, are guarded by a lock of the
+	// root scope, as a load can wait for a load of any scope of the container
+	waits := sync.Locker(&sync.Mutex{})
+	if parent != nil {
+		waits = parent.waits
+	}
+
+	return &appContainerScope{
+		mutex:        &sync.Mutex{},
+		waits:        waits,
+		parent:       parent,
+		factories:    map[string]AppContainerFactory{},
+		lifetimes:    map[string]AppContainerLifetime{},
+		types:        map[string]reflect.Type{},
+		bindings:     map[reflect.Type]string{},
+		decorators:   map[string][]AppContainerDecorator{},
+		aliases:      map[string]string{},
+		tags:         map[string][]string{},
+		entries:      map[string]interface{}{},
+		order:        []string{},
+		dependencies: map[string][]string{},
+		loading:      map[string]*appContainerLoad{},
+		overrides:    map[string][]*appContainerOverride{},
+	}
+}
+
+func (s *appContainerScope) registration(id string) (*appContainerScope, AppContainerFactory, AppContainerLifetime, bool) {
+	for scope := s; scope != nil; scope = scope.parent {
+		scope.mutex.Lock()
+		factory, ok := scope.factories[id]
+		lifetime := scope.lifetimes[id]
+		decorators := append([]AppContainerDecorator{}, scope.decorators[id]...)
+		scope.mutex.Unlock()
+
+		if ok {
+			if len(decorators) != 0 {
+				factory = decorate(factory, decorators)
+			}
+			return scope, factory, lifetime, true
+		}
+	}
+	return nil, nil, AppContainerSingleton, false
+}
+
+func (s *appContainerScope) dealias(id string) string {
+	visited := map[string]bool{}
+	for !visited[id] {
+		visited[id] = true
+
+		target, ok := "", false
+		for scope := s; scope != nil && !ok; scope = scope.parent {
+			scope.mutex.Lock()
+			target, ok = scope.aliases[id]
+			scope.mutex.Unlock()
+		}
+
+		if !ok {
+			break
+		}
+		id = target
+	}
+	return id
+}
+
+func (s *appContainerScope) lookup(typ reflect.Type) (string, error) {
+	for scope := s; scope != nil; scope = scope.parent {
+		scope.mutex.Lock()
+		id, ok := scope.bindings[typ]
+		scope.mutex.Unlock()
+
+		if ok {
+			return id, nil
+		}
+	}
+
+	candidates := []string{}
+	checked := map[string]bool{}
+	for scope := s; scope != nil; scope = scope.parent {
+		scope.mutex.Lock()
+		for id := range scope.factories {
+			if !checked[id] {
+				checked[id] = true
+				if t, ok := scope.types[id]; ok && t.AssignableTo(typ) {
+					candidates = append(candidates, id)
+				}
+			}
+		}
+		scope.mutex.Unlock()
+	}
+
+	switch len(candidates) {
+	case 0:
+		return "", nil
+	case 1:
+		return candidates[0], nil
+	}
+
+	sort.Strings(candidates)
+	return "", fmt.Errorf("ambiguous entries of type '%s' : %s", typ, strings.Join(candidates, ", "))
+}
+
+func (s *appContainerScope) tagged(tag string) []string {
+	ids := []string{}
+	if s.parent != nil {
+		ids = s.parent.tagged(tag)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, id := range s.tags[tag] {
+		found := false
+		for _, registered := range ids {
+			if registered == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
 
 // AppContainer is a object used to lazy load and store instances of
 // registered objects. This is achieved by the registration of factory
 // functions that will instantiate the instances as needed.
+// The container is safe for concurrent use, and the factory of an object
+// is executed only once, even if the object is concurrently requested
+// before its instantiation.
+// The container given to a factory is a view of the container that tracks
+// the chain of objects being resolved, so a factory requesting (directly or
+// indirectly) the object that it is instantiating will get an error instead
+// of an endless recursion.
+// Scoped child containers can be created to store the scoped objects of a
+// unit of work (like a request or a job), while falling back to the parent
+// container for the singleton objects.
 type AppContainer struct {
-	factories map[string]AppContainerFactory
-	entries   map[string]interface{}
+	*appContainerScope
+	chain    []string
+	resolved *[]string
+	load     *appContainerLoad
 }
 
 // NewAppContainer instantiates a new container object.
 func NewAppContainer() *AppContainer {
 	return &AppContainer{
-		factories: map[string]AppContainerFactory{},
-		entries:   map[string]interface{}{},
+		appContainerScope: newAppContainerScope(nil),
+		chain:             []string{},
+	}
+}
+
+// Scope instantiates a new child container. The child container will
+// instantiate and store its own instances of the scoped objects, and
+// retrieve the singleton objects from the container where they were
+// registered. Closing the child container will only close the instances
+// stored by the child.
+func (c *AppContainer) Scope() *AppContainer {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	return &AppContainer{
+		appContainerScope: newAppContainerScope(c.appContainerScope),
+		chain:             []string{},
 	}
 }
 
 // Close clean up the container from all the stored objects.
-// If the object has been already instantiated and implements the Closable
-// interface, then the Close method will be called upon the removing instance.
-func (c *AppContainer) Close() {
+// The instantiated objects are removed in the reverse order of their
+// instantiation, and if implementing the Closable or the io.Closer
+// interface, then the Close method will be called upon the removing
+// instance. All the errors returned by the closing instances are reported
+// in the returned AppErrors error.
+func (c *AppContainer) Close() error {
 	if c == nil {
 		panic(fmt.Errorf("nil pointer receiver"))
 	}
 
+	c.mutex.Lock()
+	ids := make([]string, 0, len(c.order)+len(c.entries)+len(c.factories))
+	for i := len(c.order) - 1; i >= 0; i-- {
+		ids = append(ids, c.order[i])
+	}
+	for id := range c.entries {
+		ids = append(ids, id)
+	}
 	for id := range c.factories {
-		c.Remove(id)
+		ids = append(ids, id)
+	}
+	c.mutex.Unlock()
+
+	errs := AppErrors{}
+	for _, id := range ids {
+		if err := c.Remove(id); err != nil {
+			errs = append(errs, err)
+		}
 	}
+	return errs.errorOrNil()
 }
 
-// Has will check if a object is registered with the requested id.
+// Has will check if a object is registered with the requested id or alias.
 // This does not mean that is instantiated. The instantiation is just executed
 // when the instance is requested for the first time.
 func (c AppContainer) Has(id string) bool {
-	_, ok := c.factories[id]
+	_, _, _, ok := c.registration(c.dealias(id))
+	return ok
+}
+
+// Instantiated will check if the object registered with the requested id or
+// alias was already instantiated by the container. Transient objects are
+// never stored, so they are never reported as instantiated.
+func (c AppContainer) Instantiated(id string) bool {
+	id = c.dealias(id)
+	scope, _, lifetime, ok := c.registration(id)
+	if !ok {
+		return false
+	}
+
+	if lifetime != AppContainerSingleton {
+		scope = c.appContainerScope
+	}
+
+	scope.mutex.Lock()
+	defer scope.mutex.Unlock()
+
+	_, ok = scope.entries[id]
 	return ok
 }
 
 // Add will register the requested object defined by his factory method with
-// the requested id value.
+// the requested id value, as a singleton object, and optionally marked with
+// the given list of tags.
 // If any object was registered previously with the requested id, then the
 // object will be removed by calling the Remove method previously the storing
 // of the new object factory.
-func (c *AppContainer) Add(id string, factory AppContainerFactory) error {
+func (c *AppContainer) Add(id string, factory AppContainerFactory, tags ...string) error {
 	if c == nil {
 		panic(fmt.Errorf("nil pointer receiver"))
 	}
 
-	if factory == nil {
-		return fmt.Errorf("invalid nil 'factory' argument")
+	return c.add(id, factory, AppContainerSingleton, nil, tags)
+}
+
+// AddConstructor will register a singleton object that will be instantiated
+// by the given constructor function. The constructor arguments are
+// resolved by type when the object is requested : the *AppContainer argument
+// type receives the container, the types bound to an id with the Bind method
+// will be retrieved by that id, and any other type will be resolved to the
+// unique object registered with a constructor that returns a value
+// assignable to it.
+// The constructor must return the instance and, optionally, an error.
+func (c *AppContainer) AddConstructor(id string, constructor interface{}, tags ...string) error {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
 	}
 
-	c.Remove(id)
-	c.factories[id] = factory
+	if constructor == nil {
+		return fmt.Errorf("invalid nil 'constructor' argument")
+	}
+
+	if err := checkConstructor(constructor); err != nil {
+		return err
+	}
+
+	fn := reflect.ValueOf(constructor)
+	fnType := fn.Type()
+	factory := func(container *AppContainer) (interface{}, error) {
+		args := make([]reflect.Value, fnType.NumIn())
+		for i := range args {
+			arg, err := container.resolve(fnType.In(i))
+			if err != nil {
+				return nil, fmt.Errorf("argument '%s' resolution error : %w", fnType.In(i), err)
+			}
+			args[i] = arg
+		}
+
+		return construct(fn, args)
+	}
+
+	return c.add(id, factory, AppContainerSingleton, fnType.Out(0), tags)
+}
+
+// Extend will register a decorator to be applied to the object registered
+// with the requested id when instantiated. The decorators are applied in
+// the order in which they were registered, and the result of the last one
+// is the object stored by the container.
+// The decorators are discarded if the object is removed or replaced.
+func (c *AppContainer) Extend(id string, decorator AppContainerDecorator) error {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	if decorator == nil {
+		return fmt.Errorf("invalid nil 'decorator' argument")
+	}
+
+	id = c.dealias(id)
+	scope, _, _, ok := c.registration(id)
+	if !ok {
+		return &AppContainerError{ID: id, Err: ErrNotRegistered}
+	}
+
+	scope.mutex.Lock()
+	defer scope.mutex.Unlock()
+
+	if _, ok := scope.entries[id]; ok {
+		return fmt.Errorf("entry '%s' already instantiated", id)
+	}
+
+	scope.decorators[id] = append(scope.decorators[id], decorator)
 	return nil
 }
 
+// Alias will register an alternative name that can be used to reference
+// the object registered with the requested id.
+func (c *AppContainer) Alias(alias, id string) error {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	if _, _, _, ok := c.registration(alias); ok {
+		return fmt.Errorf("entry '%s' already registered in the container", alias)
+	}
+
+	if c.dealias(id) == alias {
+		return fmt.Errorf("circular alias detected : %s -> %s", alias, id)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.aliases[alias] = id
+	return nil
+}
+
+// Bind will define the id of the object to be used when a constructor
+// registered with the AddConstructor method requires an argument of the
+// given type. This will take precedence over the type resolution of the
+// constructor registered objects.
+func (c *AppContainer) Bind(typ reflect.Type, id string) error {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	if typ == nil {
+		return fmt.Errorf("invalid nil 'typ' argument")
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.bindings[typ] = id
+	return nil
+}
+
+// AddTransient will register the requested object defined by his factory
+// method with the requested id value, as a transient object, meaning that
+// the factory will be called on every request of the object.
+func (c *AppContainer) AddTransient(id string, factory AppContainerFactory, tags ...string) error {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	return c.add(id, factory, AppContainerTransient, nil, tags)
+}
+
+// AddScoped will register the requested object defined by his factory
+// method with the requested id value, as a scoped object, meaning that
+// every container scope will store its own instance of the object.
+func (c *AppContainer) AddScoped(id string, factory AppContainerFactory, tags ...string) error {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	return c.add(id, factory, AppContainerScoped, nil, tags)
+}
+
 // Remove will eliminate the object from the container.
 // If the object has been already instantiated and implements the Closable
-// interface, then the Close method will be called on the removing instance.
-func (c *AppContainer) Remove(id string) {
+// or the io.Closer interface, then the Close method will be called on the
+// removing instance, returning the closing error if any.
+func (c *AppContainer) Remove(id string) error {
 	if c == nil {
 		panic(fmt.Errorf("nil pointer receiver"))
 	}
 
-	if entry, ok := c.entries[id]; ok {
-		switch e := entry.(type) {
-		case Closable:
-			e.Close()
+	c.mutex.Lock()
+	entry, ok := c.entries[id]
+	layers := c.overrides[id]
+	delete(c.overrides, id)
+	delete(c.aliases, id)
+	delete(c.decorators, id)
+	delete(c.factories, id)
+	delete(c.lifetimes, id)
+	delete(c.types, id)
+	for tag, ids := range c.tags {
+		for i, registered := range ids {
+			if registered == id {
+				c.tags[tag] = append(ids[:i:i], ids[i+1:]...)
+				break
+			}
+		}
+		if len(c.tags[tag]) == 0 {
+			delete(c.tags, tag)
 		}
 	}
-	delete(c.factories, id)
-	delete(c.entries, id)
+	c.discard(id)
+	c.mutex.Unlock()
+
+	errs := AppErrors{}
+	if ok {
+		if err := c.close(entry); err != nil {
+			errs = append(errs, fmt.Errorf("entry '%s' close error : %w", id, err))
+		}
+	}
+	for _, layer := range layers {
+		if layer.instantiated {
+			if err := c.close(layer.entry); err != nil {
+				errs = append(errs, fmt.Errorf("entry '%s' close error : %w", id, err))
+			}
+		}
+	}
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errs.errorOrNil()
 }
 
-// Get will retrieve the requested object from the container.
+// Override will replace the factory of the object registered with the
+// requested id, returning a function that will restore the replaced factory
+// and instance. The replaced instance is kept untouched while overridden,
+// and the instance created by the overriding factory is closed when the
+// original object is restored.
+// Several overrides can be nested, and restored in any order.
+func (c *AppContainer) Override(id string, factory AppContainerFactory) (func() error, error) {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	if factory == nil {
+		return nil, fmt.Errorf("invalid nil 'factory' argument")
+	}
+
+	id = c.dealias(id)
+
+	c.mutex.Lock()
+	layer := &appContainerOverride{}
+	layer.factory, layer.registered = c.factories[id]
+	layer.lifetime = c.lifetimes[id]
+	layer.decorators = c.decorators[id]
+	layer.entry, layer.instantiated = c.entries[id]
+	layer.dependencies = c.dependencies[id]
+
+	delete(c.decorators, id)
+	c.discard(id)
+	c.factories[id] = factory
+	c.lifetimes[id] = layer.lifetime
+	c.overrides[id] = append(c.overrides[id], layer)
+	c.mutex.Unlock()
+
+	once := sync.Once{}
+	return func() (err error) {
+		once.Do(func() {
+			err = c.restore(id, layer)
+		})
+		return err
+	}, nil
+}
+
+// Get will retrieve the requested object, by id or alias, from the container.
 // If the object has not yet been instantiated, then the factory method will be
 // executed to instantiate it.
 func (c *AppContainer) Get(id string) (interface{}, error) {
@@ -84,18 +531,650 @@ func (c *AppContainer) Get(id string) (interface{}, error) {
 		panic(fmt.Errorf("nil pointer receiver"))
 	}
 
+	id = c.dealias(id)
+	for _, link := range c.chain {
+		if link == id {
+			chain := append(append([]string{}, c.chain...), id)
+			return nil, &AppContainerError{ID: id, Err: ErrCycle, Chain: chain}
+		}
+	}
+	c.trace(id)
+
+	c.mutex.Lock()
+	entry, ok := c.entries[id]
+	c.mutex.Unlock()
+	if ok {
+		return entry, nil
+	}
+
+	scope, factory, lifetime, ok := c.registration(id)
+	if !ok {
+		return nil, &AppContainerError{ID: id, Err: ErrNotRegistered}
+	}
+
+	switch {
+	case lifetime == AppContainerTransient:
+		return c.call(id, factory, c.load)
+	case lifetime == AppContainerSingleton && scope != c.appContainerScope:
+		owner := &AppContainer{appContainerScope: scope, chain: c.chain, load: c.load}
+		return owner.Get(id)
+	}
+
+	return c.instantiate(id, factory)
+}
+
+// GetTagged will retrieve all the objects registered with the requested tag,
+// in the order in which they were registered. The objects registered in the
+// parent containers are retrieved prior the ones registered in the container.
+func (c *AppContainer) GetTagged(tag string) ([]interface{}, error) {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	entries := []interface{}{}
+	for _, id := range c.tagged(tag) {
+		entry, err := c.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// WarmUp will instantiate all the singleton objects registered in the
+// container, or, if a list of tags is given, all the singleton objects
+// registered with any of the given tags. This can be used to validate the
+// objects factories prior the first object request. The transient and
+// scoped objects are skipped, as their instances would not be stored by
+// the container. All the instantiation errors are reported in the returned
+// AppErrors error.
+func (c *AppContainer) WarmUp(tags ...string) error {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	var ids []string
+	if len(tags) == 0 {
+		for _, description := range c.Describe() {
+			ids = append(ids, description.ID)
+		}
+	} else {
+		checked := map[string]bool{}
+		for _, tag := range tags {
+			for _, id := range c.tagged(tag) {
+				if !checked[id] {
+					checked[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+
+	errs := AppErrors{}
+	for _, id := range ids {
+		if _, _, lifetime, ok := c.registration(id); !ok || lifetime != AppContainerSingleton {
+			continue
+		}
+		if _, err := c.Get(id); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs.errorOrNil()
+}
+
+// Describe will retrieve the description of all the objects that can be
+// retrieved from the container, sorted by id. The dependencies of an object
+// are the ids that were requested to the container by the object factory
+// on its last execution.
+func (c *AppContainer) Describe() []AppContainerDescription {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	ids := []string{}
+	checked := map[string]bool{}
+	aliases := map[string][]string{}
+	for scope := c.appContainerScope; scope != nil; scope = scope.parent {
+		scope.mutex.Lock()
+		for id := range scope.factories {
+			if !checked[id] {
+				checked[id] = true
+				ids = append(ids, id)
+			}
+		}
+		for alias, id := range scope.aliases {
+			aliases[id] = append(aliases[id], alias)
+		}
+		scope.mutex.Unlock()
+	}
+	sort.Strings(ids)
+
+	descriptions := make([]AppContainerDescription, 0, len(ids))
+	for _, id := range ids {
+		scope, _, lifetime, _ := c.registration(id)
+		description := AppContainerDescription{
+			ID:           id,
+			Lifetime:     lifetime,
+			Tags:         []string{},
+			Aliases:      append([]string{}, aliases[id]...),
+			Dependencies: []string{},
+		}
+		sort.Strings(description.Aliases)
+
+		scope.mutex.Lock()
+		for tag, tagged := range scope.tags {
+			for _, registered := range tagged {
+				if registered == id {
+					description.Tags = append(description.Tags, tag)
+				}
+			}
+		}
+		if typ, ok := scope.types[id]; ok {
+			description.Type = typ.String()
+		}
+		scope.mutex.Unlock()
+		sort.Strings(description.Tags)
+
+		if lifetime != AppContainerSingleton {
+			scope = c.appContainerScope
+		}
+
+		scope.mutex.Lock()
+		if entry, ok := scope.entries[id]; ok {
+			description.Instantiated = true
+			description.Type = fmt.Sprintf("%T", entry)
+		}
+		description.Dependencies = append(description.Dependencies, scope.dependencies[id]...)
+		scope.mutex.Unlock()
+
+		descriptions = append(descriptions, description)
+	}
+	return descriptions
+}
+
+// GraphDOT will retrieve the container objects dependency graph in the
+// graphviz DOT language.
+func (c *AppContainer) GraphDOT() string {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	buffer := bytes.Buffer{}
+	buffer.WriteString("digraph container {\n")
+	for _, description := range c.Describe() {
+		label := description.ID
+		if description.Type != "" {
+			label += "\n" + description.Type
+		}
+		style := "dashed"
+		if description.Instantiated {
+			style = "solid"
+		}
+		buffer.WriteString(fmt.Sprintf("\t%q [label=%q, style=%s];\n", description.ID, label, style))
+
+		for _, dependency := range description.Dependencies {
+			buffer.WriteString(fmt.Sprintf("\t%q -> %q;\n", description.ID, dependency))
+		}
+	}
+	buffer.WriteString("}\n")
+	return buffer.String()
+}
+
+// GraphJSON will retrieve the container objects dependency graph encoded
+// in JSON, as a list of nodes, holding the objects descriptions, and a
+// list of edges, holding the dependencies between the objects.
+func (c *AppContainer) GraphJSON() ([]byte, error) {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	type edge struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	}
+
+	graph := struct {
+		Nodes []AppContainerDescription `json:"nodes"`
+		Edges []edge                    `json:"edges"`
+	}{
+		Nodes: c.Describe(),
+		Edges: []edge{},
+	}
+
+	for _, description := range graph.Nodes {
+		for _, dependency := range description.Dependencies {
+			graph.Edges = append(graph.Edges, edge{From: description.ID, To: dependency})
+		}
+	}
+	return json.Marshal(graph)
+}
+
+// Inject will assign the exported fields of the struct referenced by the
+// given pointer that are tagged with the servlet tag, with the objects
+// retrieved from the container. The tag value defines the id of the object
+// to be assigned, or, if empty, the object is resolved by the field type in
+// the same way as the constructor arguments. A field can be marked as
+// optional (ex: `servlet:"id,optional"`), meaning that the field will be
+// left untouched if no object is registered to be assigned.
+// All the fields that could not be assigned are reported in the returned
+// AppErrors error.
+func (c *AppContainer) Inject(target interface{}) error {
+	if c == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	if target == nil {
+		return fmt.Errorf("invalid nil 'target' argument")
+	}
+
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("invalid non-struct pointer '%T' target", target)
+	}
+	value = value.Elem()
+
+	errs := AppErrors{}
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		tag, ok := field.Tag.Lookup(AppContainerInjectTag)
+		if !ok {
+			continue
+		}
+
+		if err := c.inject(value.Field(i), field, tag); err != nil {
+			errs = append(errs, fmt.Errorf("field '%s' injection error : %w", field.Name, err))
+		}
+	}
+	return errs.errorOrNil()
+}
+
+func (c *AppContainer) inject(value reflect.Value, field reflect.StructField, tag string) error {
+	if field.PkgPath != "" {
+		return fmt.Errorf("unexported field")
+	}
+
+	options := strings.Split(tag, ",")
+	id := strings.TrimSpace(options[0])
+	optional := false
+	for _, option := range options[1:] {
+		switch strings.TrimSpace(option) {
+		case "optional":
+			optional = true
+		default:
+			return fmt.Errorf("unknown '%s' tag option", option)
+		}
+	}
+
+	if id == "" {
+		if field.Type == reflect.TypeOf(c) {
+			value.Set(reflect.ValueOf(c))
+			return nil
+		}
+
+		var err error
+		if id, err = c.lookup(field.Type); err != nil {
+			return err
+		}
+		if id == "" {
+			if optional {
+				return nil
+			}
+			return fmt.Errorf("no entry of type '%s' registered in the container", field.Type)
+		}
+	} else if optional && !c.Has(id) {
+		return nil
+	}
+
+	entry, err := c.resolveID(id, field.Type)
+	if err != nil {
+		return err
+	}
+
+	value.Set(entry)
+	return nil
+}
+
+func (c *AppContainer) add(id string, factory AppContainerFactory, lifetime AppContainerLifetime, typ reflect.Type, tags []string) error {
+	if factory == nil {
+		return fmt.Errorf("invalid nil 'factory' argument")
+	}
+
+	_ = c.Remove(id)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.factories[id] = factory
+	c.lifetimes[id] = lifetime
+	if typ != nil {
+		c.types[id] = typ
+	}
+	for _, tag := range tags {
+		if ids := c.tags[tag]; len(ids) == 0 || ids[len(ids)-1] != id {
+			c.tags[tag] = append(ids, id)
+		}
+	}
+	return nil
+}
+
+func (c *AppContainer) instantiate(id string, factory AppContainerFactory) (entry interface{}, err error) {
+	c.mutex.Lock()
 	if entry, ok := c.entries[id]; ok {
+		c.mutex.Unlock()
 		return entry, nil
 	}
 
-	if factory, ok := c.factories[id]; ok {
-		entry, err := factory(c)
+	if load, ok := c.loading[id]; ok {
+		c.mutex.Unlock()
+		if err := c.wait(id, load); err != nil {
+			return nil, err
+		}
+		return load.entry, load.err
+	}
+
+	load := &appContainerLoad{
+		done:   make(chan struct{}),
+		err:    &AppContainerError{ID: id, Err: ErrFactoryFailed, Cause: fmt.Errorf("instantiation aborted")},
+		parent: c.load,
+	}
+	c.loading[id] = load
+	c.mutex.Unlock()
+
+	c.nest(load)
+	defer c.unnest(load)
+	defer close(load.done)
+	defer func() {
+		c.mutex.Lock()
+		// the instance is only stored if the entry was not removed
+		// or replaced while the factory was being executed
+		stored := c.loading[id] == load
+		if stored {
+			delete(c.loading, id)
+			if load.err == nil {
+				c.entries[id] = load.entry
+				c.order = append(c.order, id)
+			}
+		}
+		c.mutex.Unlock()
+
+		// otherwise, the orphan instance is closed as it would be if
+		// removed from the container, and neither the caller nor the
+		// waiters of the load will receive it
+		if !stored && load.err == nil {
+			_ = c.close(load.entry)
+			load.entry = nil
+			load.err = &AppContainerError{ID: id, Err: ErrFactoryFailed, Cause: fmt.Errorf("instantiation aborted")}
+		}
+		entry, err = load.entry, load.err
+	}()
+
+	load.entry, load.err = c.call(id, factory, load)
+	return load.entry, load.err
+}
+
+func (c *AppContainer) call(id string, factory AppContainerFactory, load *appContainerLoad) (entry interface{}, err error) {
+	view := c.resolving(id)
+	view.load = load
+	defer c.depend(id, view)
+	defer func() {
+		if r := recover(); r != nil {
+			entry = nil
+			err = &AppContainerError{ID: id, Err: ErrFactoryFailed, Cause: panicError(r), Stack: debug.Stack()}
+		}
+	}()
+
+	if entry, err = factory(view); err != nil {
+		return nil, &AppContainerError{ID: id, Err: ErrFactoryFailed, Cause: err}
+	}
+	return entry, nil
+}
+
+func (c *AppContainer) nest(load *appContainerLoad) {
+	if load.parent == nil {
+		return
+	}
+
+	c.waits.Lock()
+	defer c.waits.Unlock()
+
+	load.parent.child = load
+}
+
+func (c *AppContainer) unnest(load *appContainerLoad) {
+	if load.parent == nil {
+		return
+	}
+
+	c.waits.Lock()
+	defer c.waits.Unlock()
+
+	if load.parent.child == load {
+		load.parent.child = nil
+	}
+}
+
+// wait will block until the termination of the given in-flight load of an
+// entry, unless the load is (directly or indirectly) waiting for the load
+// being executed by the caller, in which case a cycle error is returned.
+// The loads being executed by a goroutine are linked from the outermost to
+// the innermost, and the innermost one is linked to the load it is waiting
+// for, so following these links from the awaited load will reach the
+// caller load if the wait would never end.
+func (c *AppContainer) wait(id string, load *appContainerLoad) error {
+	if c.load == nil {
+		<-load.done
+		return nil
+	}
+
+	c.waits.Lock()
+	visited := map[*appContainerLoad]bool{}
+	for link := load; link != nil && !visited[link]; {
+		if link == c.load {
+			c.waits.Unlock()
+			chain := append(append([]string{}, c.chain...), id)
+			return &AppContainerError{ID: id, Err: ErrCycle, Chain: chain}
+		}
+
+		visited[link] = true
+		if link.child != nil {
+			link = link.child
+		} else {
+			link = link.waiting
+		}
+	}
+	c.load.waiting = load
+	c.waits.Unlock()
+
+	<-load.done
+
+	c.waits.Lock()
+	c.load.waiting = nil
+	c.waits.Unlock()
+	return nil
+}
+
+func (c *AppContainer) discard(id string) {
+	delete(c.entries, id)
+	delete(c.loading, id)
+	delete(c.dependencies, id)
+	for i, instantiated := range c.order {
+		if instantiated == id {
+			c.order = append(c.order[:i:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
+
+func (c *AppContainer) restore(id string, layer *appContainerOverride) error {
+	c.mutex.Lock()
+	layers := c.overrides[id]
+	index := -1
+	for i, l := range layers {
+		if l == layer {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		c.mutex.Unlock()
+		return nil
+	}
+
+	var entry interface{}
+	var instantiated bool
+	if index == len(layers)-1 {
+		// the restored override is the active one, so the stored instance
+		// was created by the overriding factory and must be closed
+		entry, instantiated = c.entries[id]
+		c.discard(id)
+		delete(c.decorators, id)
+		if layer.registered {
+			c.factories[id] = layer.factory
+			c.lifetimes[id] = layer.lifetime
+			if len(layer.decorators) != 0 {
+				c.decorators[id] = layer.decorators
+			}
+			if layer.instantiated {
+				c.entries[id] = layer.entry
+				c.dependencies[id] = layer.dependencies
+				c.order = append(c.order, id)
+			}
+		} else {
+			delete(c.factories, id)
+			delete(c.lifetimes, id)
+		}
+	} else {
+		// the restored override was replaced by a later one, so the later
+		// one must restore the state prior the restored override instead
+		above := layers[index+1]
+		entry, instantiated = above.entry, above.instantiated
+		*above = *layer
+	}
+
+	c.overrides[id] = append(layers[:index:index], layers[index+1:]...)
+	if len(c.overrides[id]) == 0 {
+		delete(c.overrides, id)
+	}
+	c.mutex.Unlock()
+
+	if instantiated {
+		if err := c.close(entry); err != nil {
+			return fmt.Errorf("entry '%s' close error : %w", id, err)
+		}
+	}
+	return nil
+}
+
+func checkConstructor(constructor interface{}) error {
+	fnType := reflect.TypeOf(constructor)
+	switch {
+	case fnType.Kind() != reflect.Func:
+		return fmt.Errorf("invalid non-function '%T' constructor", constructor)
+	case fnType.IsVariadic():
+		return fmt.Errorf("invalid variadic '%T' constructor", constructor)
+	case fnType.NumOut() == 0 || fnType.NumOut() > 2,
+		fnType.NumOut() == 2 && fnType.Out(1) != reflect.TypeOf((*error)(nil)).Elem():
+		return fmt.Errorf("invalid '%T' constructor return signature", constructor)
+	}
+	return nil
+}
+
+func construct(fn reflect.Value, args []reflect.Value) (interface{}, error) {
+	out := fn.Call(args)
+	if len(out) == 2 && !out[1].IsNil() {
+		return nil, out[1].Interface().(error)
+	}
+	return out[0].Interface(), nil
+}
+
+func decorate(factory AppContainerFactory, decorators []AppContainerDecorator) AppContainerFactory {
+	return func(container *AppContainer) (interface{}, error) {
+		entry, err := factory(container)
 		if err != nil {
 			return nil, err
 		}
-		c.entries[id] = entry
-		return c.entries[id], nil
+
+		for _, decorator := range decorators {
+			if entry, err = decorator(entry, container); err != nil {
+				return nil, err
+			}
+		}
+		return entry, nil
+	}
+}
+
+func (c *AppContainer) resolve(typ reflect.Type) (reflect.Value, error) {
+	if typ == reflect.TypeOf(c) {
+		return reflect.ValueOf(c), nil
+	}
+
+	id, err := c.lookup(typ)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if id == "" {
+		return reflect.Value{}, fmt.Errorf("no entry of type '%s' registered in the container", typ)
+	}
+
+	return c.resolveID(id, typ)
+}
+
+func (c *AppContainer) resolveID(id string, typ reflect.Type) (reflect.Value, error) {
+	entry, err := c.Get(id)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	value := reflect.ValueOf(entry)
+	switch {
+	case !value.IsValid():
+		switch typ.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+			return reflect.Zero(typ), nil
+		}
+	case value.Type().AssignableTo(typ):
+		return value, nil
 	}
+	return reflect.Value{}, fmt.Errorf("entry '%s' of type '%T' is not assignable to '%s'", id, entry, typ)
+}
 
-	return nil, fmt.Errorf("entry '%s' not registered in the container", id)
+func (c *AppContainer) resolving(id string) *AppContainer {
+	view := *c
+	view.chain = append(append([]string{}, c.chain...), id)
+	view.resolved = &[]string{}
+	return &view
+}
+
+func (c *AppContainer) trace(id string) {
+	if c.resolved == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, resolved := range *c.resolved {
+		if resolved == id {
+			return
+		}
+	}
+	*c.resolved = append(*c.resolved, id)
+}
+
+func (c *AppContainer) depend(id string, view *AppContainer) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.dependencies[id] = append([]string{}, *view.resolved...)
+}
+
+func (c *AppContainer) close(entry interface{}) error {
+	switch e := entry.(type) {
+	case Closable:
+		e.Close()
+	case io.Closer:
+		return e.Close()
+	}
+	return nil
 }
@@ -0,0 +1,6 @@
+package servlet
+
+// AppContainerDecorator is a callback function used to wrap or modify an
+// object instantiated by the application container, prior its storage and
+// retrieval.
+type AppContainerDecorator func(interface{}, *AppContainer) (interface{}, error)
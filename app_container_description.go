@@ -0,0 +1,13 @@
+package servlet
+
+// AppContainerDescription defines the information reported by the
+// application container about a registered object.
+type AppContainerDescription struct {
+	ID           string               `json:"id"`
+	Lifetime     AppContainerLifetime `json:"lifetime"`
+	Instantiated bool                 `json:"instantiated"`
+	Type         string               `json:"type,omitempty"`
+	Tags         []string             `json:"tags"`
+	Aliases      []string             `json:"aliases"`
+	Dependencies []string             `json:"dependencies"`
+}
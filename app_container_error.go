@@ -0,0 +1,56 @@
+package servlet
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrNotRegistered defines the error reported when a requested object
+	// is not registered in the application container.
+	ErrNotRegistered = errors.New("entry not registered in the container")
+
+	// ErrFactoryFailed defines the error reported when the factory of a
+	// requested object returns an error or panics.
+	ErrFactoryFailed = errors.New("entry factory failed")
+
+	// ErrCycle defines the error reported when a requested object depends,
+	// directly or indirectly, on itself.
+	ErrCycle = errors.New("circular dependency detected")
+)
+
+// AppContainerError defines the error reported by the application container
+// when an object could not be retrieved. The error can be matched with the
+// ErrNotRegistered, ErrFactoryFailed and ErrCycle errors, and unwraps to the
+// error that caused the factory failure.
+type AppContainerError struct {
+	ID    string
+	Err   error
+	Cause error
+	Chain []string
+	Stack []byte
+}
+
+// Error will retrieve the error message.
+func (e AppContainerError) Error() string {
+	switch {
+	case e.Err == ErrNotRegistered:
+		return fmt.Sprintf("entry '%s' not registered in the container", e.ID)
+	case e.Err == ErrCycle:
+		return fmt.Sprintf("circular dependency detected : %s", strings.Join(e.Chain, " -> "))
+	case e.Stack != nil:
+		return fmt.Sprintf("entry '%s' factory panic : %v", e.ID, e.Cause)
+	}
+	return fmt.Sprintf("entry '%s' factory error : %v", e.ID, e.Cause)
+}
+
+// Is will check if the error is of the target error kind.
+func (e AppContainerError) Is(target error) bool {
+	return e.Err == target
+}
+
+// Unwrap will retrieve the error that caused the factory failure.
+func (e AppContainerError) Unwrap() error {
+	return e.Cause
+}
@@ -0,0 +1,77 @@
+package servlet
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func Test_AppContainerError_Error(t *testing.T) {
+	t.Run("retrieve the error message", func(t *testing.T) {
+		scenarios := []struct {
+			err      AppContainerError
+			expected string
+		}{
+			{ // test not registered error message
+				err:      AppContainerError{ID: "id", Err: ErrNotRegistered},
+				expected: "entry 'id' not registered in the container",
+			},
+			{ // test circular dependency error message
+				err:      AppContainerError{ID: "id1", Err: ErrCycle, Chain: []string{"id1", "id2", "id1"}},
+				expected: "circular dependency detected : id1 -> id2 -> id1",
+			},
+			{ // test factory error message
+				err:      AppContainerError{ID: "id", Err: ErrFactoryFailed, Cause: fmt.Errorf("error")},
+				expected: "entry 'id' factory error : error",
+			},
+			{ // test factory panic message
+				err:      AppContainerError{ID: "id", Err: ErrFactoryFailed, Cause: fmt.Errorf("error"), Stack: []byte("stack")},
+				expected: "entry 'id' factory panic : error",
+			},
+		}
+
+		for _, scn := range scenarios {
+			if msg := scn.err.Error(); msg != scn.expected {
+				t.Errorf("returned the (%v) message", msg)
+			}
+		}
+	})
+}
+
+func Test_AppContainerError_Is(t *testing.T) {
+	t.Run("match the error kind", func(t *testing.T) {
+		cause := fmt.Errorf("error")
+		err := error(&AppContainerError{ID: "id", Err: ErrFactoryFailed, Cause: cause})
+
+		switch {
+		case !errors.Is(err, ErrFactoryFailed):
+			t.Error("didn't matched the error kind")
+		case errors.Is(err, ErrNotRegistered) || errors.Is(err, ErrCycle):
+			t.Error("matched other error kind")
+		case !errors.Is(err, cause):
+			t.Error("didn't matched the error cause")
+		}
+	})
+
+	t.Run("match a wrapped error kind", func(t *testing.T) {
+		err := fmt.Errorf("wrapper : %w", &AppContainerError{ID: "id", Err: ErrNotRegistered})
+
+		var target *AppContainerError
+		if !errors.Is(err, ErrNotRegistered) {
+			t.Error("didn't matched the error kind")
+		} else if !errors.As(err, &target) {
+			t.Error("didn't retrieved the container error")
+		} else if target.ID != "id" {
+			t.Errorf("retrieved the (%v) error id", target.ID)
+		}
+	})
+}
+
+func Test_AppContainerError_Unwrap(t *testing.T) {
+	t.Run("retrieve the error cause", func(t *testing.T) {
+		cause := fmt.Errorf("error")
+		if err := (AppContainerError{ID: "id", Err: ErrFactoryFailed, Cause: cause}).Unwrap(); err != cause {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+}
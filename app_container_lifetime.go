@@ -0,0 +1,46 @@
+package servlet
+
+// AppContainerLifetime identifies a value type that describes how the
+// instances of a container registered object are created and shared.
+type AppContainerLifetime int
+
+const (
+	// AppContainerSingleton defines a lifetime of a object that is
+	// instantiated once, and shared by the container where it was
+	// registered and all its scoped child containers.
+	AppContainerSingleton AppContainerLifetime = iota
+	// AppContainerTransient defines a lifetime of a object that is
+	// instantiated on every request. The container does not keep any
+	// reference to the created instances, so they will not be closed by
+	// the container.
+	AppContainerTransient
+	// AppContainerScoped defines a lifetime of a object that is
+	// instantiated once per container scope, and closed when the
+	// scope is closed.
+	AppContainerScoped
+)
+
+// AppContainerLifetimeMap defines a relation between a human-readable
+// string and a container object lifetime.
+var AppContainerLifetimeMap = map[string]AppContainerLifetime{
+	"singleton": AppContainerSingleton,
+	"transient": AppContainerTransient,
+	"scoped":    AppContainerScoped,
+}
+
+// String will retrieve the name of the lifetime.
+func (l AppContainerLifetime) String() string {
+	switch l {
+	case AppContainerTransient:
+		return "transient"
+	case AppContainerScoped:
+		return "scoped"
+	}
+	return "singleton"
+}
+
+// MarshalText will retrieve the name of the lifetime to be used as the
+// lifetime textual encoding.
+func (l AppContainerLifetime) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
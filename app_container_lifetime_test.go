@@ -0,0 +1,49 @@
+package servlet
+
+import (
+	"testing"
+)
+
+func Test_AppContainerLifetime_String(t *testing.T) {
+	t.Run("retrieve the lifetime name", func(t *testing.T) {
+		scenarios := []struct {
+			lifetime AppContainerLifetime
+			expected string
+		}{
+			{ // test singleton lifetime name
+				lifetime: AppContainerSingleton,
+				expected: "singleton",
+			},
+			{ // test transient lifetime name
+				lifetime: AppContainerTransient,
+				expected: "transient",
+			},
+			{ // test scoped lifetime name
+				lifetime: AppContainerScoped,
+				expected: "scoped",
+			},
+		}
+
+		for _, scn := range scenarios {
+			if name := scn.lifetime.String(); name != scn.expected {
+				t.Errorf("returned the (%v) name", name)
+			} else if text, err := scn.lifetime.MarshalText(); err != nil {
+				t.Errorf("returned the (%v) error", err)
+			} else if string(text) != scn.expected {
+				t.Errorf("returned the (%v) text", string(text))
+			}
+		}
+	})
+}
+
+func Test_AppContainerLifetimeMap(t *testing.T) {
+	t.Run("map the lifetime names", func(t *testing.T) {
+		for _, lifetime := range []AppContainerLifetime{AppContainerSingleton, AppContainerTransient, AppContainerScoped} {
+			if check, ok := AppContainerLifetimeMap[lifetime.String()]; !ok {
+				t.Errorf("didn't mapped the (%v) lifetime", lifetime)
+			} else if check != lifetime {
+				t.Errorf("mapped the (%v) lifetime", check)
+			}
+		}
+	})
+}
@@ -1,10 +1,15 @@
 package servlet
 
 import (
+	"errors"
 	"fmt"
 	"github.com/golang/mock/gomock"
 	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func Test_NewAppContainer(t *testing.T) {
@@ -26,6 +31,22 @@ func Test_NewAppContainer(t *testing.T) {
 		}
 	})
 
+	t.Run("new app container instantiate its own wait links lock", func(t *testing.T) {
+		c1 := NewAppContainer()
+		defer c1.Close()
+		c2 := NewAppContainer()
+		defer c2.Close()
+
+		scope := c1.Scope()
+		defer scope.Close()
+
+		if c1.waits == nil || c1.waits == c2.waits {
+			t.Error("didn't created a container wait links lock")
+		} else if scope.waits != c1.waits {
+			t.Error("didn't shared the root wait links lock with the scope")
+		}
+	})
+
 	t.Run("new app container instantiate a the loaded entries map", func(t *testing.T) {
 		c := NewAppContainer()
 		defer c.Close()
@@ -34,6 +55,42 @@ func Test_NewAppContainer(t *testing.T) {
 			t.Error("didn't created the loaded entries map")
 		}
 	})
+
+	t.Run("new app container instantiate the access mutex", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		if c.mutex == nil {
+			t.Error("didn't created the access mutex")
+		}
+	})
+
+	t.Run("new app container instantiate the loading entries map", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		if c.loading == nil {
+			t.Error("didn't created the loading entries map")
+		}
+	})
+
+	t.Run("new app container instantiate the lifetimes map", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		if c.lifetimes == nil {
+			t.Error("didn't created the lifetimes map")
+		}
+	})
+
+	t.Run("new app container don't have a parent scope", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		if c.parent != nil {
+			t.Error("unexpected parent scope")
+		}
+	})
 }
 
 func Test_AppContainer_Close(t *testing.T) {
@@ -78,6 +135,60 @@ func Test_AppContainer_Close(t *testing.T) {
 			t.Error("didn't removed the entry")
 		}
 	})
+	t.Run("close the entries in the reverse instantiation order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		entry1 := NewMockClosable(ctrl)
+		entry2 := NewMockLogStream(ctrl)
+		entry3 := NewMockClosable(ctrl)
+		gomock.InOrder(
+			entry3.EXPECT().Close().Times(1),
+			entry2.EXPECT().Close().Return(nil).Times(1),
+			entry1.EXPECT().Close().Times(1),
+		)
+
+		c := NewAppContainer()
+		_ = c.Add("id3", func(_ *AppContainer) (interface{}, error) { return entry3, nil })
+		_ = c.Add("id2", func(container *AppContainer) (interface{}, error) {
+			_, _ = container.Get("id1")
+			return entry2, nil
+		})
+		_ = c.Add("id1", func(_ *AppContainer) (interface{}, error) { return entry1, nil })
+		_ = c.Add("id4", func(_ *AppContainer) (interface{}, error) { return "value", nil })
+
+		_, _ = c.Get("id2")
+		_, _ = c.Get("id3")
+
+		if err := c.Close(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if len(c.factories) != 0 || len(c.entries) != 0 || len(c.order) != 0 {
+			t.Error("didn't removed all the entries")
+		}
+	})
+
+	t.Run("report all the entries close errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		entry1 := NewMockLogStream(ctrl)
+		entry1.EXPECT().Close().Return(fmt.Errorf("error 1")).Times(1)
+		entry2 := NewMockLogStream(ctrl)
+		entry2.EXPECT().Close().Return(fmt.Errorf("error 2")).Times(1)
+
+		c := NewAppContainer()
+		_ = c.Add("id1", func(_ *AppContainer) (interface{}, error) { return entry1, nil })
+		_ = c.Add("id2", func(_ *AppContainer) (interface{}, error) { return entry2, nil })
+		_, _ = c.Get("id1")
+		_, _ = c.Get("id2")
+
+		expected := "entry 'id2' close error : error 2; entry 'id1' close error : error 1"
+		if err := c.Close(); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != expected {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
 }
 
 func Test_AppContainer_Has(t *testing.T) {
@@ -101,6 +212,62 @@ func Test_AppContainer_Has(t *testing.T) {
 	})
 }
 
+func Test_AppContainer_Instantiated(t *testing.T) {
+	t.Run("unregistered entry", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		if c.Instantiated("id") {
+			t.Error("returned true")
+		}
+	})
+
+	t.Run("validate the entry instantiation", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_ = c.Alias("alias", "id")
+
+		if c.Instantiated("id") {
+			t.Error("returned true prior the instantiation")
+		}
+
+		_, _ = c.Get("id")
+		if !c.Instantiated("id") {
+			t.Error("returned false after the instantiation")
+		} else if !c.Instantiated("alias") {
+			t.Error("returned false for the entry alias")
+		}
+	})
+
+	t.Run("validate a parent singleton instantiation", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+		scope := c.Scope()
+		defer scope.Close()
+
+		_, _ = c.Get("id")
+		if !scope.Instantiated("id") {
+			t.Error("returned false")
+		}
+	})
+
+	t.Run("transient entries are never instantiated", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.AddTransient("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+
+		_, _ = c.Get("id")
+		if c.Instantiated("id") {
+			t.Error("returned true")
+		}
+	})
+}
+
 func Test_AppContainer_Add(t *testing.T) {
 	t.Run("nil pointer receiver", func(t *testing.T) {
 		defer func() {
@@ -259,6 +426,303 @@ func Test_AppContainer_Remove(t *testing.T) {
 			t.Error("didn't removed the loaded entry")
 		}
 	})
+	t.Run("return the entry close error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		id := "id"
+		expected := fmt.Errorf("error")
+
+		c := NewAppContainer()
+		defer c.Close()
+
+		entry := NewMockLogStream(ctrl)
+		entry.EXPECT().Close().Return(expected).Times(1)
+		_ = c.Add(id, func(_ *AppContainer) (interface{}, error) {
+			return entry, nil
+		})
+
+		_, _ = c.Get(id)
+		if err := c.Remove(id); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, expected) {
+			t.Errorf("returned the (%v) error", err)
+		} else if _, ok := c.entries[id]; ok {
+			t.Error("didn't removed the loaded entry")
+		}
+	})
+}
+
+func Test_AppContainer_Override(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var c *AppContainer
+		_, _ = c.Override("id", func(_ *AppContainer) (interface{}, error) {
+			return "value", nil
+		})
+	})
+
+	t.Run("nil factory", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		if restore, err := c.Override("id", nil); restore != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'factory' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("override and restore a loaded entry", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		id := "id"
+		original := NewMockClosable(ctrl)
+		original.EXPECT().Close().Times(1)
+		override := NewMockClosable(ctrl)
+		override.EXPECT().Close().Times(1)
+
+		c := NewAppContainer()
+		_ = c.Add(id, func(_ *AppContainer) (interface{}, error) {
+			return original, nil
+		})
+		_, _ = c.Get(id)
+
+		restore, err := c.Override(id, func(_ *AppContainer) (interface{}, error) {
+			return override, nil
+		})
+		if err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry, err := c.Get(id); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry != override {
+			t.Error("didn't returned the overriding entry")
+		} else if err := restore(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry, err := c.Get(id); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry != original {
+			t.Error("didn't restored the original entry")
+		}
+		_ = c.Close()
+	})
+
+	t.Run("override and restore a non-loaded entry", func(t *testing.T) {
+		id := "id"
+		calls := 0
+
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add(id, func(_ *AppContainer) (interface{}, error) {
+			calls++
+			return "original", nil
+		})
+
+		restore, _ := c.Override(id, func(_ *AppContainer) (interface{}, error) {
+			return "override", nil
+		})
+		if entry, _ := c.Get(id); entry != "override" {
+			t.Errorf("returned the (%v) entry", entry)
+		} else if calls != 0 {
+			t.Error("called the original factory")
+		} else if err := restore(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry, _ := c.Get(id); entry != "original" {
+			t.Errorf("returned the (%v) entry", entry)
+		}
+	})
+
+	t.Run("override an alias", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id", func(_ *AppContainer) (interface{}, error) {
+			return "original", nil
+		})
+		_ = c.Alias("alias", "id")
+
+		restore, _ := c.Override("alias", func(_ *AppContainer) (interface{}, error) {
+			return "override", nil
+		})
+		if entry, _ := c.Get("id"); entry != "override" {
+			t.Errorf("returned the (%v) entry", entry)
+		} else if err := restore(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry, _ := c.Get("alias"); entry != "original" {
+			t.Errorf("returned the (%v) entry", entry)
+		}
+	})
+
+	t.Run("override a non-registered entry", func(t *testing.T) {
+		id := "id"
+
+		c := NewAppContainer()
+		defer c.Close()
+
+		restore, _ := c.Override(id, func(_ *AppContainer) (interface{}, error) {
+			return "override", nil
+		})
+		if entry, _ := c.Get(id); entry != "override" {
+			t.Errorf("returned the (%v) entry", entry)
+		} else if err := restore(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if c.Has(id) {
+			t.Error("didn't removed the overriding entry")
+		}
+	})
+
+	t.Run("restore nested overrides", func(t *testing.T) {
+		scenarios := []struct {
+			order []int
+		}{
+			{ // test restoring in the reverse order of the overrides
+				order: []int{1, 0},
+			},
+			{ // test restoring in the order of the overrides
+				order: []int{0, 1},
+			},
+		}
+
+		for _, scn := range scenarios {
+			ctrl := gomock.NewController(t)
+
+			id := "id"
+			original := NewMockClosable(ctrl)
+			original.EXPECT().Close().Times(1)
+			first := NewMockClosable(ctrl)
+			first.EXPECT().Close().Times(1)
+			second := NewMockClosable(ctrl)
+			second.EXPECT().Close().Times(1)
+
+			c := NewAppContainer()
+			_ = c.Add(id, func(_ *AppContainer) (interface{}, error) {
+				return original, nil
+			})
+			_, _ = c.Get(id)
+
+			restores := make([]func() error, 2)
+			restores[0], _ = c.Override(id, func(_ *AppContainer) (interface{}, error) {
+				return first, nil
+			})
+			_, _ = c.Get(id)
+			restores[1], _ = c.Override(id, func(_ *AppContainer) (interface{}, error) {
+				return second, nil
+			})
+
+			if entry, _ := c.Get(id); entry != second {
+				t.Error("didn't returned the last overriding entry")
+			}
+			for _, i := range scn.order {
+				if err := restores[i](); err != nil {
+					t.Errorf("returned the (%v) error", err)
+				}
+			}
+			if entry, _ := c.Get(id); entry != original {
+				t.Error("didn't restored the original entry")
+			}
+
+			_ = c.Close()
+			ctrl.Finish()
+		}
+	})
+
+	t.Run("restore only once", func(t *testing.T) {
+		id := "id"
+
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add(id, func(_ *AppContainer) (interface{}, error) {
+			return "original", nil
+		})
+
+		restore, _ := c.Override(id, func(_ *AppContainer) (interface{}, error) {
+			return "first", nil
+		})
+		_ = restore()
+		_, _ = c.Override(id, func(_ *AppContainer) (interface{}, error) {
+			return "second", nil
+		})
+
+		if err := restore(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry, _ := c.Get(id); entry != "second" {
+			t.Errorf("returned the (%v) entry", entry)
+		}
+	})
+
+	t.Run("return the overriding entry close error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		id := "id"
+		expected := fmt.Errorf("error")
+
+		c := NewAppContainer()
+		defer c.Close()
+
+		entry := NewMockLogStream(ctrl)
+		entry.EXPECT().Close().Return(expected).Times(1)
+		restore, _ := c.Override(id, func(_ *AppContainer) (interface{}, error) {
+			return entry, nil
+		})
+		_, _ = c.Get(id)
+
+		if err := restore(); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, expected) {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("remove close the overridden entries", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		id := "id"
+		original := NewMockClosable(ctrl)
+		original.EXPECT().Close().Times(1)
+		override := NewMockClosable(ctrl)
+		override.EXPECT().Close().Times(1)
+
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add(id, func(_ *AppContainer) (interface{}, error) {
+			return original, nil
+		})
+		_, _ = c.Get(id)
+		restore, _ := c.Override(id, func(_ *AppContainer) (interface{}, error) {
+			return override, nil
+		})
+		_, _ = c.Get(id)
+
+		if err := c.Remove(id); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if err := restore(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if c.Has(id) {
+			t.Error("restored the removed entry")
+		}
+	})
 }
 
 func Test_AppContainer_Get(t *testing.T) {
@@ -307,16 +771,18 @@ func Test_AppContainer_Get(t *testing.T) {
 		c := NewAppContainer()
 		defer c.Close()
 
-		expectedError := "error message"
+		expectedError := fmt.Errorf("error message")
 		_ = c.Add(id, func(_ *AppContainer) (interface{}, error) {
-			return nil, fmt.Errorf(expectedError)
+			return nil, expectedError
 		})
 
 		if e, err := c.Get(id); e != nil {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error")
-		} else if err.Error() != expectedError {
+		} else if !errors.Is(err, ErrFactoryFailed) || !errors.Is(err, expectedError) {
+			t.Errorf("returned the (%v) error", err)
+		} else if err.Error() != "entry 'id' factory error : error message" {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -377,3 +843,1824 @@ func Test_AppContainer_Get(t *testing.T) {
 		}
 	})
 }
+
+func Test_AppContainer_CircularDependency(t *testing.T) {
+	t.Run("error on self dependency", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id", func(c *AppContainer) (interface{}, error) {
+			return c.Get("id")
+		})
+
+		if e, err := c.Get("id"); e != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, ErrCycle) {
+			t.Errorf("returned the (%v) error", err)
+		} else if err.Error() != "entry 'id' factory error : circular dependency detected : id -> id" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error on indirect dependency", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id1", func(c *AppContainer) (interface{}, error) {
+			return c.Get("id2")
+		})
+		_ = c.Add("id2", func(c *AppContainer) (interface{}, error) {
+			return c.Get("id3")
+		})
+		_ = c.Add("id3", func(c *AppContainer) (interface{}, error) {
+			return c.Get("id2")
+		})
+
+		if e, err := c.Get("id1"); e != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, ErrCycle) {
+			t.Errorf("returned the (%v) error", err)
+		} else if !strings.HasSuffix(err.Error(), "circular dependency detected : id1 -> id2 -> id3 -> id2") {
+			t.Errorf("returned the (%v) error", err)
+		} else if len(c.loading) != 0 {
+			t.Error("didn't cleared the loading entries")
+		} else if len(c.entries) != 0 {
+			t.Error("stored an instance")
+		}
+	})
+
+	t.Run("error on servlet providers circular dependency", func(t *testing.T) {
+		container := NewAppContainer()
+		defer container.Close()
+
+		_ = NewFileSystemProvider(nil).Register(container)
+		_ = NewConfigProvider(nil).Register(container)
+		_ = NewLogProvider(nil).Register(container)
+
+		_ = container.Add(ContainerConfigID, func(c *AppContainer) (interface{}, error) {
+			return c.Get(ContainerLogLoaderID)
+		})
+		_ = container.Add(ContainerLoggerID, func(c *AppContainer) (interface{}, error) {
+			return c.Get(ContainerConfigID)
+		})
+
+		expected := "circular dependency detected : servlet.log.loader -> servlet.log -> servlet.config -> servlet.log.loader"
+		if _, err := container.Get(ContainerLogLoaderID); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, ErrCycle) || !strings.HasSuffix(err.Error(), expected) {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("allow the same dependency in distinct branches", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id1", func(c *AppContainer) (interface{}, error) {
+			if _, err := c.Get("id2"); err != nil {
+				return nil, err
+			}
+			return c.Get("id3")
+		})
+		_ = c.Add("id2", func(c *AppContainer) (interface{}, error) {
+			return c.Get("id3")
+		})
+		_ = c.Add("id3", func(c *AppContainer) (interface{}, error) {
+			return "value", nil
+		})
+
+		if e, err := c.Get("id1"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if e != "value" {
+			t.Errorf("returned the (%v) value", e)
+		}
+	})
+}
+
+func Test_AppContainer_Concurrency(t *testing.T) {
+	t.Run("call the factory only once on concurrent requests", func(t *testing.T) {
+		id := "id"
+
+		c := NewAppContainer()
+		defer c.Close()
+
+		count := int32(0)
+		_ = c.Add(id, func(_ *AppContainer) (interface{}, error) {
+			atomic.AddInt32(&count, 1)
+			time.Sleep(10 * time.Millisecond)
+			return &struct{ value int }{}, nil
+		})
+
+		wg := sync.WaitGroup{}
+		results := make([]interface{}, 50)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], _ = c.Get(id)
+			}(i)
+		}
+		wg.Wait()
+
+		if atomic.LoadInt32(&count) != 1 {
+			t.Errorf("called the factory (%d) times", count)
+		}
+		for _, result := range results {
+			if result == nil || result != results[0] {
+				t.Error("didn't returned the same instance to all requests")
+				break
+			}
+		}
+	})
+
+	t.Run("propagate the factory error to all concurrent requests", func(t *testing.T) {
+		id := "id"
+
+		c := NewAppContainer()
+		defer c.Close()
+
+		count := int32(0)
+		_ = c.Add(id, func(_ *AppContainer) (interface{}, error) {
+			atomic.AddInt32(&count, 1)
+			time.Sleep(10 * time.Millisecond)
+			return nil, fmt.Errorf("error")
+		})
+
+		wg := sync.WaitGroup{}
+		errs := make([]error, 20)
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = c.Get(id)
+			}(i)
+		}
+		wg.Wait()
+
+		if atomic.LoadInt32(&count) != 1 {
+			t.Errorf("called the factory (%d) times", count)
+		}
+		for _, err := range errs {
+			if err == nil || err.Error() != "entry 'id' factory error : error" {
+				t.Errorf("returned the (%v) error", err)
+				break
+			}
+		}
+	})
+
+	t.Run("resolve nested entries concurrently", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		count := int32(0)
+		_ = c.Add("dependency", func(_ *AppContainer) (interface{}, error) {
+			atomic.AddInt32(&count, 1)
+			time.Sleep(5 * time.Millisecond)
+			return "dependency", nil
+		})
+		for i := 0; i < 10; i++ {
+			_ = c.Add(fmt.Sprintf("id%d", i), func(c *AppContainer) (interface{}, error) {
+				return c.Get("dependency")
+			})
+		}
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if entry, err := c.Get(fmt.Sprintf("id%d", i)); err != nil {
+					t.Errorf("returned the (%v) error", err)
+				} else if entry != "dependency" {
+					t.Errorf("returned the (%v) entry", entry)
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		if atomic.LoadInt32(&count) != 1 {
+			t.Errorf("called the dependency factory (%d) times", count)
+		}
+	})
+
+	t.Run("error on a dependency cycle between concurrent requests", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		started := sync.WaitGroup{}
+		started.Add(2)
+		_ = c.Add("a", func(c *AppContainer) (interface{}, error) {
+			started.Done()
+			started.Wait()
+			return c.Get("b")
+		})
+		_ = c.Add("b", func(c *AppContainer) (interface{}, error) {
+			started.Done()
+			started.Wait()
+			return c.Get("a")
+		})
+
+		errs := make(chan error, 2)
+		for _, id := range []string{"a", "b"} {
+			go func(id string) {
+				_, err := c.Get(id)
+				errs <- err
+			}(id)
+		}
+
+		for i := 0; i < 2; i++ {
+			select {
+			case err := <-errs:
+				if !errors.Is(err, ErrCycle) {
+					t.Errorf("returned the (%v) error", err)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("deadlocked the concurrent requests")
+			}
+		}
+	})
+
+	t.Run("wait for a concurrent request of a shared dependency", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		started := sync.WaitGroup{}
+		started.Add(2)
+		_ = c.Add("shared", func(c *AppContainer) (interface{}, error) {
+			time.Sleep(10 * time.Millisecond)
+			return "shared", nil
+		})
+		_ = c.Add("a", func(c *AppContainer) (interface{}, error) {
+			started.Done()
+			started.Wait()
+			return c.Get("shared")
+		})
+		_ = c.Add("b", func(c *AppContainer) (interface{}, error) {
+			started.Done()
+			started.Wait()
+			return c.Get("shared")
+		})
+
+		errs := make(chan error, 2)
+		for _, id := range []string{"a", "b"} {
+			go func(id string) {
+				_, err := c.Get(id)
+				errs <- err
+			}(id)
+		}
+
+		for i := 0; i < 2; i++ {
+			if err := <-errs; err != nil {
+				t.Errorf("returned the (%v) error", err)
+			}
+		}
+	})
+
+	t.Run("close the instance of an entry removed while instantiating", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := NewAppContainer()
+		defer c.Close()
+
+		entry := NewMockClosable(ctrl)
+		entry.EXPECT().Close().Times(1)
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) {
+			close(started)
+			<-release
+			return entry, nil
+		})
+
+		type result struct {
+			entry interface{}
+			err   error
+		}
+		results := make(chan result, 2)
+		get := func() {
+			entry, err := c.Get("id")
+			results <- result{entry, err}
+		}
+
+		go get()
+		<-started
+		go get()
+		time.Sleep(10 * time.Millisecond)
+
+		_ = c.Remove("id")
+		close(release)
+
+		for i := 0; i < 2; i++ {
+			if r := <-results; r.entry != nil {
+				t.Error("returned the closed instance")
+			} else if r.err == nil {
+				t.Error("didn't returned the expected error")
+			}
+		}
+
+		if c.Instantiated("id") {
+			t.Error("stored the instance of the removed entry")
+		}
+	})
+
+	t.Run("close the instance of an entry overridden while instantiating", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := NewAppContainer()
+		defer c.Close()
+
+		entry := NewMockClosable(ctrl)
+		entry.EXPECT().Close().Times(1)
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) {
+			close(started)
+			<-release
+			return entry, nil
+		})
+
+		type result struct {
+			entry interface{}
+			err   error
+		}
+		results := make(chan result, 2)
+		get := func() {
+			entry, err := c.Get("id")
+			results <- result{entry, err}
+		}
+
+		go get()
+		<-started
+		go get()
+		time.Sleep(10 * time.Millisecond)
+
+		_, _ = c.Override("id", func(*AppContainer) (interface{}, error) {
+			return "override", nil
+		})
+		close(release)
+
+		for i := 0; i < 2; i++ {
+			r := <-results
+			if r.entry == entry {
+				t.Error("returned the closed instance")
+			} else if r.entry == nil && r.err == nil {
+				t.Error("didn't returned the expected error")
+			}
+		}
+	})
+
+	t.Run("concurrent registration, retrieval and removal", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				id := fmt.Sprintf("id%d", i%5)
+				_ = c.Add(id, func(_ *AppContainer) (interface{}, error) {
+					return i, nil
+				})
+				_ = c.Has(id)
+				_, _ = c.Get(id)
+				c.Remove(id)
+			}(i)
+		}
+		wg.Wait()
+	})
+}
+
+func Test_AppContainer_AddTransient(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var c *AppContainer
+		_ = c.AddTransient("id", func(*AppContainer) (interface{}, error) { return nil, nil })
+	})
+
+	t.Run("nil factory", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		if err := c.AddTransient("id", nil); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'factory' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("call the factory on every request", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		count := 0
+		_ = c.AddTransient("id", func(*AppContainer) (interface{}, error) {
+			count++
+			return &struct{ value int }{value: count}, nil
+		})
+
+		first, _ := c.Get("id")
+		second, _ := c.Get("id")
+
+		switch {
+		case count != 2:
+			t.Errorf("called the factory (%d) times", count)
+		case first == second:
+			t.Error("returned the same instance")
+		}
+	})
+
+	t.Run("don't store the transient instances", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		entry := NewMockClosable(ctrl)
+		entry.EXPECT().Close().Times(0)
+
+		c := NewAppContainer()
+		_ = c.AddTransient("id", func(*AppContainer) (interface{}, error) {
+			return entry, nil
+		})
+
+		_, _ = c.Get("id")
+		if _, ok := c.entries["id"]; ok {
+			t.Error("stored the transient instance")
+		}
+		c.Close()
+	})
+
+	t.Run("return the factory error", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		expected := fmt.Errorf("error")
+		_ = c.AddTransient("id", func(*AppContainer) (interface{}, error) {
+			return nil, expected
+		})
+
+		if _, err := c.Get("id"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, expected) {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+}
+
+func Test_AppContainer_AddScoped(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var c *AppContainer
+		_ = c.AddScoped("id", func(*AppContainer) (interface{}, error) { return nil, nil })
+	})
+
+	t.Run("nil factory", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		if err := c.AddScoped("id", nil); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'factory' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("store the instance in the requesting scope", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		count := 0
+		_ = c.AddScoped("id", func(*AppContainer) (interface{}, error) {
+			count++
+			return &struct{ value int }{value: count}, nil
+		})
+
+		scope1 := c.Scope()
+		defer scope1.Close()
+		scope2 := c.Scope()
+		defer scope2.Close()
+
+		first, _ := scope1.Get("id")
+		second, _ := scope1.Get("id")
+		third, _ := scope2.Get("id")
+
+		switch {
+		case count != 2:
+			t.Errorf("called the factory (%d) times", count)
+		case first != second:
+			t.Error("didn't returned the same instance in the same scope")
+		case first == third:
+			t.Error("returned the same instance in different scopes")
+		}
+		if _, ok := c.entries["id"]; ok {
+			t.Error("stored the scoped instance in the parent container")
+		}
+	})
+}
+
+func Test_AppContainer_Scope(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var c *AppContainer
+		c.Scope()
+	})
+
+	t.Run("create a child container", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		scope := c.Scope()
+		defer scope.Close()
+
+		switch {
+		case scope == nil:
+			t.Error("didn't returned a valid reference")
+		case scope.parent != c.appContainerScope:
+			t.Error("didn't stored the parent scope")
+		case scope.appContainerScope == c.appContainerScope:
+			t.Error("shared the parent scope storage")
+		}
+	})
+
+	t.Run("check the parent registrations", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+
+		scope := c.Scope()
+		defer scope.Close()
+
+		if !scope.Has("id") {
+			t.Error("didn't found the parent registration")
+		}
+	})
+
+	t.Run("resolve the singletons in the parent container", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		count := 0
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) {
+			count++
+			return &struct{ value int }{value: count}, nil
+		})
+
+		scope1 := c.Scope()
+		scope2 := c.Scope()
+
+		first, _ := scope1.Get("id")
+		second, _ := scope2.Get("id")
+		scope1.Close()
+		scope2.Close()
+		third, _ := c.Get("id")
+
+		switch {
+		case count != 1:
+			t.Errorf("called the factory (%d) times", count)
+		case first != second || first != third:
+			t.Error("didn't returned the same instance")
+		}
+	})
+
+	t.Run("resolve the scoped dependencies with the requesting scope", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.AddScoped("dependency", func(*AppContainer) (interface{}, error) {
+			return &struct{ value int }{}, nil
+		})
+		_ = c.AddScoped("id", func(container *AppContainer) (interface{}, error) {
+			return container.Get("dependency")
+		})
+
+		scope := c.Scope()
+		defer scope.Close()
+
+		entry, _ := scope.Get("id")
+		dependency, _ := scope.Get("dependency")
+
+		if entry != dependency {
+			t.Error("didn't resolved the dependency in the requesting scope")
+		}
+	})
+
+	t.Run("detect circular dependencies across scopes", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id1", func(container *AppContainer) (interface{}, error) {
+			return container.Get("id2")
+		})
+
+		scope := c.Scope()
+		defer scope.Close()
+		_ = scope.Add("id2", func(container *AppContainer) (interface{}, error) {
+			return container.Get("id1")
+		})
+
+		expected := "entry 'id2' factory error : entry 'id1' factory error : circular dependency detected : id2 -> id1 -> id2"
+		if _, err := scope.Get("id2"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != expected {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("close only the scope instances", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		singleton := NewMockClosable(ctrl)
+		singleton.EXPECT().Close().Times(1)
+		scoped := NewMockClosable(ctrl)
+		scoped.EXPECT().Close().Times(1)
+
+		c := NewAppContainer()
+		_ = c.Add("singleton", func(*AppContainer) (interface{}, error) { return singleton, nil })
+		_ = c.AddScoped("scoped", func(*AppContainer) (interface{}, error) { return scoped, nil })
+
+		scope := c.Scope()
+		_, _ = scope.Get("singleton")
+		_, _ = scope.Get("scoped")
+		scope.Close()
+
+		if !c.Has("singleton") || !c.Has("scoped") {
+			t.Error("removed the parent registrations")
+		}
+
+		c.Close()
+	})
+}
+
+func Test_AppContainer_GetTagged(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var c *AppContainer
+		_, _ = c.GetTagged("tag")
+	})
+
+	t.Run("empty list if no entry is tagged", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+
+		if entries, err := c.GetTagged("tag"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if len(entries) != 0 {
+			t.Errorf("returned the (%v) entries", entries)
+		}
+	})
+
+	t.Run("retrieve the tagged entries in registration order", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id1", func(*AppContainer) (interface{}, error) { return "value1", nil }, "tag1")
+		_ = c.Add("id2", func(*AppContainer) (interface{}, error) { return "value2", nil }, "tag1", "tag2")
+		_ = c.AddTransient("id3", func(*AppContainer) (interface{}, error) { return "value3", nil }, "tag1", "tag1")
+		_ = c.Add("id4", func(*AppContainer) (interface{}, error) { return "value4", nil })
+
+		scenarios := []struct {
+			tag      string
+			expected []interface{}
+		}{
+			{ // test retrieving the entries of the first tag
+				tag:      "tag1",
+				expected: []interface{}{"value1", "value2", "value3"},
+			},
+			{ // test retrieving the entries of the second tag
+				tag:      "tag2",
+				expected: []interface{}{"value2"},
+			},
+		}
+
+		for _, scn := range scenarios {
+			if entries, err := c.GetTagged(scn.tag); err != nil {
+				t.Errorf("returned the (%v) error", err)
+			} else if !reflect.DeepEqual(entries, scn.expected) {
+				t.Errorf("returned the (%v) entries for the (%s) tag", entries, scn.tag)
+			}
+		}
+	})
+
+	t.Run("don't retrieve removed entries", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id1", func(*AppContainer) (interface{}, error) { return "value1", nil }, "tag")
+		_ = c.Add("id2", func(*AppContainer) (interface{}, error) { return "value2", nil }, "tag")
+		c.Remove("id1")
+		_ = c.Add("id2", func(*AppContainer) (interface{}, error) { return "value3", nil })
+
+		if entries, err := c.GetTagged("tag"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if len(entries) != 0 {
+			t.Errorf("returned the (%v) entries", entries)
+		}
+	})
+
+	t.Run("return the entry instantiation error", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		expected := fmt.Errorf("error")
+		_ = c.Add("id1", func(*AppContainer) (interface{}, error) { return "value1", nil }, "tag")
+		_ = c.Add("id2", func(*AppContainer) (interface{}, error) { return nil, expected }, "tag")
+
+		if entries, err := c.GetTagged("tag"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, expected) {
+			t.Errorf("returned the (%v) error", err)
+		} else if entries != nil {
+			t.Errorf("returned the (%v) entries", entries)
+		}
+	})
+
+	t.Run("retrieve the parent tagged entries prior the scope entries", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id1", func(*AppContainer) (interface{}, error) { return "value1", nil }, "tag")
+		_ = c.AddScoped("id2", func(*AppContainer) (interface{}, error) { return "value2", nil }, "tag")
+
+		scope := c.Scope()
+		defer scope.Close()
+		_ = scope.Add("id3", func(*AppContainer) (interface{}, error) { return "value3", nil }, "tag")
+		_ = scope.Add("id1", func(*AppContainer) (interface{}, error) { return "value4", nil }, "tag")
+
+		expected := []interface{}{"value4", "value2", "value3"}
+		if entries, err := scope.GetTagged("tag"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !reflect.DeepEqual(entries, expected) {
+			t.Errorf("returned the (%v) entries", entries)
+		}
+	})
+}
+
+func Test_AppContainer_AddConstructor(t *testing.T) {
+	type dependency struct{ value string }
+	type service struct{ dependency *dependency }
+
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var c *AppContainer
+		_ = c.AddConstructor("id", func() *dependency { return nil })
+	})
+
+	t.Run("invalid constructors", func(t *testing.T) {
+		scenarios := []struct {
+			constructor interface{}
+			expected    string
+		}{
+			{ // test nil constructor
+				constructor: nil,
+				expected:    "invalid nil 'constructor' argument",
+			},
+			{ // test non-function constructor
+				constructor: "string",
+				expected:    "invalid non-function 'string' constructor",
+			},
+			{ // test variadic constructor
+				constructor: func(...int) *dependency { return nil },
+				expected:    "invalid variadic 'func(...int) *servlet.dependency' constructor",
+			},
+			{ // test constructor without return values
+				constructor: func() {},
+				expected:    "invalid 'func()' constructor return signature",
+			},
+			{ // test constructor with a non-error second return value
+				constructor: func() (*dependency, int) { return nil, 0 },
+				expected:    "invalid 'func() (*servlet.dependency, int)' constructor return signature",
+			},
+			{ // test constructor with too many return values
+				constructor: func() (*dependency, int, error) { return nil, 0, nil },
+				expected:    "invalid 'func() (*servlet.dependency, int, error)' constructor return signature",
+			},
+		}
+
+		for _, scn := range scenarios {
+			c := NewAppContainer()
+			if err := c.AddConstructor("id", scn.constructor); err == nil {
+				t.Error("didn't returned the expected error")
+			} else if err.Error() != scn.expected {
+				t.Errorf("returned the (%v) error", err)
+			} else if c.Has("id") {
+				t.Error("registered the invalid constructor")
+			}
+			c.Close()
+		}
+	})
+
+	t.Run("resolve the arguments by type", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.AddConstructor("dependency", func() *dependency {
+			return &dependency{value: "value"}
+		})
+		_ = c.AddConstructor("service", func(d *dependency) (*service, error) {
+			return &service{dependency: d}, nil
+		})
+
+		entry, err := c.Get("service")
+		dep, _ := c.Get("dependency")
+
+		switch {
+		case err != nil:
+			t.Errorf("returned the (%v) error", err)
+		case entry.(*service).dependency != dep:
+			t.Error("didn't injected the dependency instance")
+		}
+	})
+
+	t.Run("inject the container", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		var injected *AppContainer
+		_ = c.AddConstructor("id", func(container *AppContainer) *dependency {
+			injected = container
+			return &dependency{}
+		})
+
+		if _, err := c.Get("id"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if injected == nil || injected.appContainerScope != c.appContainerScope {
+			t.Error("didn't injected the container")
+		}
+	})
+
+	t.Run("resolve interface arguments by assignable types", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.AddConstructor("config", func() (*Config, error) { return NewConfig(0) })
+		_ = c.AddConstructor("id", func(closable Closable) *dependency {
+			return &dependency{value: fmt.Sprintf("%T", closable)}
+		})
+
+		if entry, err := c.Get("id"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry.(*dependency).value != "*servlet.Config" {
+			t.Errorf("injected the (%v) argument", entry.(*dependency).value)
+		}
+	})
+
+	t.Run("error on missing argument type", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("dependency", func(*AppContainer) (interface{}, error) { return &dependency{}, nil })
+		_ = c.AddConstructor("service", func(d *dependency) *service { return &service{dependency: d} })
+
+		expected := "entry 'service' factory error : argument '*servlet.dependency' resolution error : no entry of type '*servlet.dependency' registered in the container"
+		if _, err := c.Get("service"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != expected {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error on ambiguous argument type", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.AddConstructor("dependency2", func() *dependency { return &dependency{} })
+		_ = c.AddConstructor("dependency1", func() *dependency { return &dependency{} })
+		_ = c.AddConstructor("service", func(d *dependency) *service { return &service{dependency: d} })
+
+		expected := "entry 'service' factory error : argument '*servlet.dependency' resolution error : ambiguous entries of type '*servlet.dependency' : dependency1, dependency2"
+		if _, err := c.Get("service"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != expected {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error on argument instantiation", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		expected := fmt.Errorf("error")
+		_ = c.AddConstructor("dependency", func() (*dependency, error) { return nil, expected })
+		_ = c.AddConstructor("service", func(d *dependency) *service { return &service{dependency: d} })
+
+		if _, err := c.Get("service"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, expected) {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("return the constructor error", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		expected := fmt.Errorf("error")
+		_ = c.AddConstructor("id", func() (*dependency, error) { return &dependency{}, expected })
+
+		if entry, err := c.Get("id"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, expected) {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry != nil {
+			t.Errorf("returned the (%v) entry", entry)
+		} else if _, ok := c.entries["id"]; ok {
+			t.Error("stored the failed instance")
+		}
+	})
+
+	t.Run("discard the argument type on entry replacement", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.AddConstructor("dependency", func() *dependency { return &dependency{} })
+		_ = c.Add("dependency", func(*AppContainer) (interface{}, error) { return &dependency{}, nil })
+
+		if _, ok := c.types["dependency"]; ok {
+			t.Error("didn't discarded the entry type")
+		}
+	})
+}
+
+func Test_AppContainer_Bind(t *testing.T) {
+	type dependency struct{ value string }
+
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var c *AppContainer
+		_ = c.Bind(reflect.TypeOf(&dependency{}), "id")
+	})
+
+	t.Run("nil type", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		if err := c.Bind(nil, "id"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'typ' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("resolve the bound type by id", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.AddConstructor("dependency1", func() *dependency { return &dependency{value: "value1"} })
+		_ = c.Add("dependency2", func(*AppContainer) (interface{}, error) { return &dependency{value: "value2"}, nil })
+		_ = c.Bind(reflect.TypeOf(&dependency{}), "dependency2")
+		_ = c.AddConstructor("id", func(d *dependency) string { return d.value })
+
+		if entry, err := c.Get("id"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry != "value2" {
+			t.Errorf("injected the (%v) dependency", entry)
+		}
+	})
+
+	t.Run("resolve a nil bound entry", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("dependency", func(*AppContainer) (interface{}, error) { return nil, nil })
+		_ = c.Bind(reflect.TypeOf(&dependency{}), "dependency")
+		_ = c.AddConstructor("id", func(d *dependency) bool { return d == nil })
+
+		if entry, err := c.Get("id"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry != true {
+			t.Error("didn't injected a nil dependency")
+		}
+	})
+
+	t.Run("error on non-assignable bound entry", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("dependency", func(*AppContainer) (interface{}, error) { return "string", nil })
+		_ = c.Bind(reflect.TypeOf(&dependency{}), "dependency")
+		_ = c.AddConstructor("id", func(d *dependency) string { return d.value })
+
+		expected := "entry 'id' factory error : argument '*servlet.dependency' resolution error : entry 'dependency' of type 'string' is not assignable to '*servlet.dependency'"
+		if _, err := c.Get("id"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != expected {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("resolve the parent bindings in a scope", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("dependency", func(*AppContainer) (interface{}, error) { return &dependency{value: "value"}, nil })
+		_ = c.Bind(reflect.TypeOf(&dependency{}), "dependency")
+
+		scope := c.Scope()
+		defer scope.Close()
+		_ = scope.AddConstructor("id", func(d *dependency) string { return d.value })
+
+		if entry, err := scope.Get("id"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry != "value" {
+			t.Errorf("injected the (%v) dependency", entry)
+		}
+	})
+}
+
+func Test_AppContainer_Inject(t *testing.T) {
+	type dependency struct{ value string }
+
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var c *AppContainer
+		_ = c.Inject(&struct{}{})
+	})
+
+	t.Run("invalid targets", func(t *testing.T) {
+		scenarios := []struct {
+			target   interface{}
+			expected string
+		}{
+			{ // test nil target
+				target:   nil,
+				expected: "invalid nil 'target' argument",
+			},
+			{ // test non-pointer target
+				target:   struct{}{},
+				expected: "invalid non-struct pointer 'struct {}' target",
+			},
+			{ // test nil pointer target
+				target:   (*struct{})(nil),
+				expected: "invalid non-struct pointer '*struct {}' target",
+			},
+			{ // test non-struct pointer target
+				target:   new(int),
+				expected: "invalid non-struct pointer '*int' target",
+			},
+		}
+
+		for _, scn := range scenarios {
+			c := NewAppContainer()
+			if err := c.Inject(scn.target); err == nil {
+				t.Error("didn't returned the expected error")
+			} else if err.Error() != scn.expected {
+				t.Errorf("returned the (%v) error", err)
+			}
+			c.Close()
+		}
+	})
+
+	t.Run("assign the tagged fields", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("string", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_ = c.AddConstructor("dependency", func() *dependency { return &dependency{value: "dependency"} })
+
+		target := struct {
+			String     string        `servlet:"string"`
+			Dependency *dependency   `servlet:""`
+			Container  *AppContainer `servlet:""`
+			Untagged   string
+		}{Untagged: "untagged"}
+
+		if err := c.Inject(&target); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if target.String != "value" {
+			t.Errorf("assigned the (%v) string field", target.String)
+		} else if target.Dependency == nil || target.Dependency.value != "dependency" {
+			t.Errorf("assigned the (%v) dependency field", target.Dependency)
+		} else if target.Container != c {
+			t.Error("didn't assigned the container field")
+		} else if target.Untagged != "untagged" {
+			t.Errorf("assigned the (%v) untagged field", target.Untagged)
+		}
+	})
+
+	t.Run("assign interface fields", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		config, _ := NewConfig(0)
+		_ = c.Add("config", func(*AppContainer) (interface{}, error) { return config, nil })
+
+		target := struct {
+			Closable Closable `servlet:"config"`
+		}{}
+
+		if err := c.Inject(&target); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if target.Closable != config {
+			t.Error("didn't assigned the interface field")
+		}
+	})
+
+	t.Run("skip the missing optional fields", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		target := struct {
+			String     string      `servlet:"string, optional"`
+			Dependency *dependency `servlet:",optional"`
+		}{String: "default"}
+
+		if err := c.Inject(&target); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if target.String != "default" {
+			t.Errorf("assigned the (%v) string field", target.String)
+		} else if target.Dependency != nil {
+			t.Errorf("assigned the (%v) dependency field", target.Dependency)
+		}
+	})
+
+	t.Run("report all the fields that could not be assigned", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("string", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_ = c.Add("error", func(*AppContainer) (interface{}, error) { return nil, fmt.Errorf("error") })
+
+		target := struct {
+			Missing    string      `servlet:"missing"`
+			Invalid    int         `servlet:"string"`
+			Failing    string      `servlet:"error,optional"`
+			Dependency *dependency `servlet:""`
+			Option     string      `servlet:"string,unknown"`
+			String     string      `servlet:"string"`
+			unexported string      `servlet:"string"`
+		}{}
+
+		expected := "field 'Missing' injection error : entry 'missing' not registered in the container; " +
+			"field 'Invalid' injection error : entry 'string' of type 'string' is not assignable to 'int'; " +
+			"field 'Failing' injection error : entry 'error' factory error : error; " +
+			"field 'Dependency' injection error : no entry of type '*servlet.dependency' registered in the container; " +
+			"field 'Option' injection error : unknown 'unknown' tag option; " +
+			"field 'unexported' injection error : unexported field"
+
+		err := c.Inject(&target)
+		if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != expected {
+			t.Errorf("returned the (%v) error", err)
+		} else if errs, ok := err.(AppErrors); !ok || len(errs) != 6 {
+			t.Errorf("didn't returned the aggregated errors : %v", err)
+		} else if target.String != "value" {
+			t.Errorf("didn't assigned the (%v) valid field", target.String)
+		}
+		_ = target.unexported
+	})
+}
+
+func Test_AppContainer_Extend(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var c *AppContainer
+		_ = c.Extend("id", func(entry interface{}, _ *AppContainer) (interface{}, error) { return entry, nil })
+	})
+
+	t.Run("nil decorator", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+
+		if err := c.Extend("id", nil); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'decorator' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error extending a non registered entry", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		decorator := func(entry interface{}, _ *AppContainer) (interface{}, error) { return entry, nil }
+		if err := c.Extend("id", decorator); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "entry 'id' not registered in the container" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error extending an instantiated entry", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_, _ = c.Get("id")
+
+		decorator := func(entry interface{}, _ *AppContainer) (interface{}, error) { return entry, nil }
+		if err := c.Extend("id", decorator); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "entry 'id' already instantiated" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("apply the decorators in order", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("suffix", func(*AppContainer) (interface{}, error) { return "!", nil })
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_ = c.Extend("id", func(entry interface{}, _ *AppContainer) (interface{}, error) {
+			return entry.(string) + " decorated", nil
+		})
+		_ = c.Extend("id", func(entry interface{}, container *AppContainer) (interface{}, error) {
+			suffix, err := container.Get("suffix")
+			if err != nil {
+				return nil, err
+			}
+			return entry.(string) + suffix.(string), nil
+		})
+
+		if entry, err := c.Get("id"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry != "value decorated!" {
+			t.Errorf("returned the (%v) entry", entry)
+		}
+	})
+
+	t.Run("decorate every transient instance", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		count := 0
+		_ = c.AddTransient("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_ = c.Extend("id", func(entry interface{}, _ *AppContainer) (interface{}, error) {
+			count++
+			return entry, nil
+		})
+
+		_, _ = c.Get("id")
+		_, _ = c.Get("id")
+
+		if count != 2 {
+			t.Errorf("called the decorator (%d) times", count)
+		}
+	})
+
+	t.Run("return the decorator error", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		expected := fmt.Errorf("error")
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_ = c.Extend("id", func(interface{}, *AppContainer) (interface{}, error) { return nil, expected })
+
+		if entry, err := c.Get("id"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, expected) {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry != nil {
+			t.Errorf("returned the (%v) entry", entry)
+		}
+	})
+
+	t.Run("discard the decorators on entry replacement", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_ = c.Extend("id", func(entry interface{}, _ *AppContainer) (interface{}, error) {
+			return entry.(string) + " decorated", nil
+		})
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "replaced", nil })
+
+		if entry, err := c.Get("id"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry != "replaced" {
+			t.Errorf("returned the (%v) entry", entry)
+		}
+	})
+}
+
+func Test_AppContainer_Alias(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var c *AppContainer
+		_ = c.Alias("alias", "id")
+	})
+
+	t.Run("error aliasing with a registered id", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+
+		if err := c.Alias("id", "other"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "entry 'id' already registered in the container" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error on circular aliases", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Alias("alias1", "alias2")
+
+		if err := c.Alias("alias2", "alias1"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "circular alias detected : alias2 -> alias1" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("retrieve the entry by alias", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return &struct{ value int }{}, nil })
+		_ = c.Alias("alias1", "id")
+		_ = c.Alias("alias2", "alias1")
+
+		entry, _ := c.Get("id")
+		alias1, err1 := c.Get("alias1")
+		alias2, err2 := c.Get("alias2")
+
+		switch {
+		case err1 != nil || err2 != nil:
+			t.Errorf("returned the (%v, %v) errors", err1, err2)
+		case !c.Has("alias2"):
+			t.Error("didn't found the aliased entry")
+		case entry != alias1 || entry != alias2:
+			t.Error("didn't returned the aliased entry")
+		}
+	})
+
+	t.Run("alias an entry registered later", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Alias("alias", "id")
+		if c.Has("alias") {
+			t.Error("found the alias of a non registered entry")
+		}
+
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+		if entry, err := c.Get("alias"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry != "value" {
+			t.Errorf("returned the (%v) entry", entry)
+		}
+	})
+
+	t.Run("extend the entry by alias", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_ = c.Alias("alias", "id")
+		_ = c.Extend("alias", func(entry interface{}, _ *AppContainer) (interface{}, error) {
+			return entry.(string) + " decorated", nil
+		})
+
+		if entry, err := c.Get("id"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry != "value decorated" {
+			t.Errorf("returned the (%v) entry", entry)
+		}
+	})
+
+	t.Run("remove the alias", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_ = c.Alias("alias", "id")
+		c.Remove("alias")
+
+		if c.Has("alias") {
+			t.Error("didn't removed the alias")
+		} else if !c.Has("id") {
+			t.Error("removed the aliased entry")
+		}
+	})
+
+	t.Run("resolve the parent aliases in a scope", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_ = c.Alias("alias", "id")
+
+		scope := c.Scope()
+		defer scope.Close()
+
+		if entry, err := scope.Get("alias"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry != "value" {
+			t.Errorf("returned the (%v) entry", entry)
+		}
+	})
+}
+
+func Test_AppContainer_Describe(t *testing.T) {
+	type dependency struct{}
+
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var c *AppContainer
+		c.Describe()
+	})
+
+	t.Run("describe the registered entries", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id2", func(container *AppContainer) (interface{}, error) {
+			_, _ = container.Get("alias")
+			_, _ = container.Get("id1")
+			_, _ = container.Get("missing")
+			return "value", nil
+		}, "tag2", "tag1")
+		_ = c.AddConstructor("id1", func() *dependency { return &dependency{} })
+		_ = c.AddTransient("id3", func(*AppContainer) (interface{}, error) { return 123, nil })
+		_ = c.Alias("alias", "id1")
+		_, _ = c.Get("id2")
+
+		expected := []AppContainerDescription{
+			{
+				ID:           "id1",
+				Lifetime:     AppContainerSingleton,
+				Instantiated: true,
+				Type:         "*servlet.dependency",
+				Tags:         []string{},
+				Aliases:      []string{"alias"},
+				Dependencies: []string{},
+			},
+			{
+				ID:           "id2",
+				Lifetime:     AppContainerSingleton,
+				Instantiated: true,
+				Type:         "string",
+				Tags:         []string{"tag1", "tag2"},
+				Aliases:      []string{},
+				Dependencies: []string{"id1", "missing"},
+			},
+			{
+				ID:           "id3",
+				Lifetime:     AppContainerTransient,
+				Instantiated: false,
+				Type:         "",
+				Tags:         []string{},
+				Aliases:      []string{},
+				Dependencies: []string{},
+			},
+		}
+
+		if descriptions := c.Describe(); !reflect.DeepEqual(descriptions, expected) {
+			t.Errorf("returned the (%v) descriptions", descriptions)
+		}
+	})
+
+	t.Run("describe the constructor type of a non instantiated entry", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.AddConstructor("id", func() *dependency { return &dependency{} })
+
+		if descriptions := c.Describe(); len(descriptions) != 1 {
+			t.Errorf("returned the (%v) descriptions", descriptions)
+		} else if descriptions[0].Instantiated {
+			t.Error("described the entry as instantiated")
+		} else if descriptions[0].Type != "*servlet.dependency" {
+			t.Errorf("described the (%v) type", descriptions[0].Type)
+		}
+	})
+
+	t.Run("describe the scoped entries of the scope", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("singleton", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_ = c.AddScoped("scoped", func(container *AppContainer) (interface{}, error) {
+			return container.Get("singleton")
+		})
+
+		scope := c.Scope()
+		defer scope.Close()
+		_, _ = scope.Get("scoped")
+
+		parent := c.Describe()
+		child := scope.Describe()
+
+		switch {
+		case len(parent) != 2 || len(child) != 2:
+			t.Errorf("returned the (%v, %v) descriptions", parent, child)
+		case parent[0].Instantiated || !child[0].Instantiated:
+			t.Error("didn't described the scoped entry in the requesting scope")
+		case !reflect.DeepEqual(child[0].Dependencies, []string{"singleton"}):
+			t.Errorf("described the (%v) dependencies", child[0].Dependencies)
+		case !parent[1].Instantiated || !child[1].Instantiated:
+			t.Error("didn't described the singleton entry in the registering container")
+		}
+	})
+}
+
+func Test_AppContainer_GraphDOT(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var c *AppContainer
+		c.GraphDOT()
+	})
+
+	t.Run("export the dependency graph", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id1", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_ = c.Add("id2", func(container *AppContainer) (interface{}, error) {
+			return container.Get("id1")
+		})
+		_ = c.Add("id3", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_, _ = c.Get("id2")
+
+		expected := "digraph container {\n" +
+			"\t\"id1\" [label=\"id1\\nstring\", style=solid];\n" +
+			"\t\"id2\" [label=\"id2\\nstring\", style=solid];\n" +
+			"\t\"id2\" -> \"id1\";\n" +
+			"\t\"id3\" [label=\"id3\", style=dashed];\n" +
+			"}\n"
+
+		if graph := c.GraphDOT(); graph != expected {
+			t.Errorf("returned the (%v) graph", graph)
+		}
+	})
+}
+
+func Test_AppContainer_GraphJSON(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var c *AppContainer
+		_, _ = c.GraphJSON()
+	})
+
+	t.Run("export the dependency graph", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id1", func(*AppContainer) (interface{}, error) { return "value", nil }, "tag")
+		_ = c.AddScoped("id2", func(container *AppContainer) (interface{}, error) {
+			return container.Get("id1")
+		})
+		_, _ = c.Get("id2")
+
+		expected := `{"nodes":[` +
+			`{"id":"id1","lifetime":"singleton","instantiated":true,"type":"string","tags":["tag"],"aliases":[],"dependencies":[]},` +
+			`{"id":"id2","lifetime":"scoped","instantiated":true,"type":"string","tags":[],"aliases":[],"dependencies":["id1"]}` +
+			`],"edges":[{"from":"id2","to":"id1"}]}`
+
+		if graph, err := c.GraphJSON(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if string(graph) != expected {
+			t.Errorf("returned the (%v) graph", string(graph))
+		}
+	})
+}
+
+func Test_AppContainer_WarmUp(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var c *AppContainer
+		_ = c.WarmUp()
+	})
+
+	t.Run("instantiate all the registered singleton entries", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		c := NewAppContainer()
+		defer c.Close()
+
+		called := false
+		transient := NewMockClosable(ctrl)
+		_ = c.Add("id1", func(*AppContainer) (interface{}, error) { return "value1", nil })
+		_ = c.AddScoped("id2", func(*AppContainer) (interface{}, error) { return "value2", nil })
+		_ = c.AddTransient("id3", func(*AppContainer) (interface{}, error) {
+			called = true
+			return transient, nil
+		})
+
+		if err := c.WarmUp(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !reflect.DeepEqual(c.order, []string{"id1"}) {
+			t.Errorf("instantiated the (%v) entries", c.order)
+		} else if called {
+			t.Error("instantiated the transient entry")
+		}
+	})
+
+	t.Run("instantiate only the tagged entries", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id1", func(*AppContainer) (interface{}, error) { return "value1", nil }, "tag1")
+		_ = c.Add("id2", func(*AppContainer) (interface{}, error) { return "value2", nil }, "tag1", "tag2")
+		_ = c.Add("id3", func(*AppContainer) (interface{}, error) { return "value3", nil }, "tag3")
+		_ = c.Add("id4", func(*AppContainer) (interface{}, error) { return "value4", nil })
+
+		if err := c.WarmUp("tag1", "tag2"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !reflect.DeepEqual(c.order, []string{"id1", "id2"}) {
+			t.Errorf("instantiated the (%v) entries", c.order)
+		}
+	})
+
+	t.Run("report all the instantiation errors", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.Add("id1", func(*AppContainer) (interface{}, error) { return nil, fmt.Errorf("error 1") })
+		_ = c.Add("id2", func(*AppContainer) (interface{}, error) { return "value", nil })
+		_ = c.Add("id3", func(container *AppContainer) (interface{}, error) { return container.Get("missing") })
+
+		expected := "entry 'id1' factory error : error 1; " +
+			"entry 'id3' factory error : entry 'missing' not registered in the container"
+
+		if err := c.WarmUp(); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != expected {
+			t.Errorf("returned the (%v) error", err)
+		} else if _, ok := c.entries["id2"]; !ok {
+			t.Error("didn't instantiated the valid entry")
+		}
+	})
+}
+
+func Test_AppContainer_FactoryPanic(t *testing.T) {
+	t.Run("convert a factory error panic", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		expected := fmt.Errorf("error")
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) {
+			panic(expected)
+		})
+
+		var target *AppContainerError
+		if entry, err := c.Get("id"); entry != nil {
+			t.Errorf("returned the (%v) entry", entry)
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, ErrFactoryFailed) || !errors.Is(err, expected) {
+			t.Errorf("returned the (%v) error", err)
+		} else if err.Error() != "entry 'id' factory panic : error" {
+			t.Errorf("returned the (%v) error", err)
+		} else if !errors.As(err, &target) || len(target.Stack) == 0 {
+			t.Error("didn't stored the panic stack trace")
+		}
+	})
+
+	t.Run("convert a factory non-error panic", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		_ = c.AddTransient("id", func(*AppContainer) (interface{}, error) {
+			panic("message")
+		})
+
+		if _, err := c.Get("id"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, ErrFactoryFailed) {
+			t.Errorf("returned the (%v) error", err)
+		} else if err.Error() != "entry 'id' factory panic : message" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("don't store the panicking entry", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		count := 0
+		_ = c.Add("id", func(*AppContainer) (interface{}, error) {
+			count++
+			panic("message")
+		})
+
+		_, _ = c.Get("id")
+		_, _ = c.Get("id")
+
+		if count != 2 {
+			t.Errorf("called the factory (%d) times", count)
+		} else if _, ok := c.entries["id"]; ok {
+			t.Error("stored the panicking entry")
+		}
+	})
+
+	t.Run("report the not registered error kind", func(t *testing.T) {
+		c := NewAppContainer()
+		defer c.Close()
+
+		if _, err := c.Get("id"); !errors.Is(err, ErrNotRegistered) {
+			t.Errorf("returned the (%v) error", err)
+		} else if err := c.Extend("id", func(e interface{}, _ *AppContainer) (interface{}, error) { return e, nil }); !errors.Is(err, ErrNotRegistered) {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+}
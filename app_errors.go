@@ -0,0 +1,57 @@
+package servlet
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AppErrors defines a list of errors collected while executing a process
+// composed by several independent steps, like the application shutdown,
+// where the failure of one step should not prevent the execution of the
+// remaining ones.
+type AppErrors []error
+
+// Error will retrieve the concatenation of all the stored error messages.
+func (e AppErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Is will check if any of the stored errors matches the target error.
+func (e AppErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As will search the stored errors for the first one that can be assigned
+// to the target reference.
+func (e AppErrors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e AppErrors) errorOrNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+func panicError(r interface{}) error {
+	if err, ok := r.(error); ok {
+		return err
+	}
+	return fmt.Errorf("%v", r)
+}
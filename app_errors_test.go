@@ -0,0 +1,75 @@
+package servlet
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+)
+
+func Test_AppErrors_Error(t *testing.T) {
+	t.Run("concatenate the stored error messages", func(t *testing.T) {
+		errs := AppErrors{fmt.Errorf("error 1"), fmt.Errorf("error 2")}
+
+		if msg := errs.Error(); msg != "error 1; error 2" {
+			t.Errorf("returned the (%v) message", msg)
+		}
+	})
+}
+
+func Test_AppErrors_Is(t *testing.T) {
+	t.Run("match a stored error", func(t *testing.T) {
+		expected := fmt.Errorf("error")
+		errs := AppErrors{fmt.Errorf("other"), fmt.Errorf("wrapped : %w", expected)}
+
+		if !errors.Is(errs, expected) {
+			t.Error("didn't matched the stored error")
+		}
+	})
+
+	t.Run("don't match a non-stored error", func(t *testing.T) {
+		errs := AppErrors{fmt.Errorf("error")}
+
+		if errors.Is(errs, fmt.Errorf("error")) {
+			t.Error("matched a non-stored error")
+		}
+	})
+}
+
+func Test_AppErrors_As(t *testing.T) {
+	t.Run("assign a stored error", func(t *testing.T) {
+		expected := &os.PathError{Op: "open", Path: "path", Err: fmt.Errorf("error")}
+		errs := AppErrors{fmt.Errorf("other"), fmt.Errorf("wrapped : %w", expected)}
+
+		var target *os.PathError
+		if !errors.As(errs, &target) {
+			t.Error("didn't assigned the stored error")
+		} else if target != expected {
+			t.Errorf("assigned the (%v) error", target)
+		}
+	})
+
+	t.Run("don't assign a non-stored error type", func(t *testing.T) {
+		errs := AppErrors{fmt.Errorf("error")}
+
+		var target *os.PathError
+		if errors.As(errs, &target) {
+			t.Error("assigned a non-stored error type")
+		}
+	})
+}
+
+func Test_panicError(t *testing.T) {
+	t.Run("retrieve the error panic value", func(t *testing.T) {
+		expected := fmt.Errorf("error")
+		if err := panicError(expected); err != expected {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("convert a non-error panic value", func(t *testing.T) {
+		if err := panicError("message"); err == nil || err.Error() != "message" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+}
@@ -0,0 +1,8 @@
+package servlet
+
+// AppEvent defines the information of an event propagated by the
+// application event dispatcher.
+type AppEvent struct {
+	Name string
+	Data interface{}
+}
@@ -0,0 +1,141 @@
+package servlet
+
+import (
+	"fmt"
+	"sync"
+)
+
+type appEventRefListener struct {
+	id       int
+	async    bool
+	listener AppEventListener
+}
+
+// AppEventDispatcher defines the instance used to propagate application
+// events to the listeners that subscribed them.
+type AppEventDispatcher struct {
+	mutex     sync.Locker
+	listeners map[string][]appEventRefListener
+	sequence  int
+	running   *sync.WaitGroup
+}
+
+// NewAppEventDispatcher instantiate a new event dispatcher.
+func NewAppEventDispatcher() *AppEventDispatcher {
+	return &AppEventDispatcher{
+		mutex:     &sync.Mutex{},
+		listeners: map[string][]appEventRefListener{},
+		sequence:  0,
+		running:   &sync.WaitGroup{},
+	}
+}
+
+// Close will wait for the termination of all the running asynchronous
+// listeners.
+func (d *AppEventDispatcher) Close() {
+	if d == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	d.running.Wait()
+}
+
+// Subscribe will register a listener that will be synchronously called when
+// an event with the requested name is dispatched. The AppEventAll name
+// can be used to subscribe all the dispatched events. The returned value is
+// the id of the subscription that can be used to unsubscribe the listener.
+func (d *AppEventDispatcher) Subscribe(name string, listener AppEventListener) (int, error) {
+	if d == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	return d.subscribe(name, listener, false)
+}
+
+// SubscribeAsync will register a listener that will be called in a
+// dedicated go routine when an event with the requested name is dispatched.
+// The AppEventAll name can be used to subscribe all the dispatched events.
+// The returned value is the id of the subscription that can be used to
+// unsubscribe the listener.
+func (d *AppEventDispatcher) SubscribeAsync(name string, listener AppEventListener) (int, error) {
+	if d == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	return d.subscribe(name, listener, true)
+}
+
+// Unsubscribe will remove the listener subscription with the requested id.
+func (d *AppEventDispatcher) Unsubscribe(id int) {
+	if d == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	for name, listeners := range d.listeners {
+		for i, ref := range listeners {
+			if ref.id == id {
+				d.listeners[name] = append(listeners[:i:i], listeners[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Dispatch will propagate the event to all the listeners subscribed to the
+// event name, and to the ones subscribed to all events. A panic raised by a
+// listener will not prevent the execution of the remaining listeners. The
+// panics raised by the synchronous listeners are returned as errors.
+func (d *AppEventDispatcher) Dispatch(event AppEvent) error {
+	if d == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	d.mutex.Lock()
+	listeners := append(append([]appEventRefListener{}, d.listeners[event.Name]...), d.listeners[AppEventAll]...)
+	d.mutex.Unlock()
+
+	errs := AppErrors{}
+	for _, ref := range listeners {
+		if ref.async {
+			d.running.Add(1)
+			go func(listener AppEventListener) {
+				defer d.running.Done()
+				_ = d.call(listener, event)
+			}(ref.listener)
+			continue
+		}
+
+		if err := d.call(ref.listener, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs.errorOrNil()
+}
+
+func (d *AppEventDispatcher) subscribe(name string, listener AppEventListener, async bool) (int, error) {
+	if listener == nil {
+		return 0, fmt.Errorf("invalid nil 'listener' argument")
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.sequence++
+	d.listeners[name] = append(d.listeners[name], appEventRefListener{d.sequence, async, listener})
+
+	return d.sequence, nil
+}
+
+func (d *AppEventDispatcher) call(listener AppEventListener, event AppEvent) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("event '%s' listener panic : %v", event.Name, r)
+		}
+	}()
+
+	listener(event)
+	return nil
+}
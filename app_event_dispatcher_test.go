@@ -0,0 +1,280 @@
+package servlet
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_NewAppEventDispatcher(t *testing.T) {
+	t.Run("instantiate a new event dispatcher", func(t *testing.T) {
+		if d := NewAppEventDispatcher(); d == nil {
+			t.Error("didn't returned a valid reference")
+		} else if d.mutex == nil {
+			t.Error("didn't created the access mutex")
+		} else if d.listeners == nil {
+			t.Error("didn't created the listeners map")
+		} else if d.running == nil {
+			t.Error("didn't created the running listeners wait group")
+		}
+	})
+}
+
+func Test_AppEventDispatcher_Close(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var d *AppEventDispatcher
+		d.Close()
+	})
+
+	t.Run("wait for the running asynchronous listeners", func(t *testing.T) {
+		d := NewAppEventDispatcher()
+
+		mutex := &sync.Mutex{}
+		check := false
+		_, _ = d.SubscribeAsync("event", func(AppEvent) {
+			time.Sleep(20 * time.Millisecond)
+			mutex.Lock()
+			check = true
+			mutex.Unlock()
+		})
+		_ = d.Dispatch(AppEvent{Name: "event"})
+		d.Close()
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		if !check {
+			t.Error("didn't waited for the asynchronous listener")
+		}
+	})
+}
+
+func Test_AppEventDispatcher_Subscribe(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var d *AppEventDispatcher
+		_, _ = d.Subscribe("event", func(AppEvent) {})
+	})
+
+	t.Run("nil listener", func(t *testing.T) {
+		if _, err := NewAppEventDispatcher().Subscribe("event", nil); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'listener' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("register listeners with distinct ids", func(t *testing.T) {
+		d := NewAppEventDispatcher()
+
+		if id1, err := d.Subscribe("event", func(AppEvent) {}); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if id2, err := d.Subscribe("event", func(AppEvent) {}); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if id1 == id2 {
+			t.Error("returned the same subscription id")
+		} else if len(d.listeners["event"]) != 2 {
+			t.Error("didn't stored the listeners")
+		}
+	})
+}
+
+func Test_AppEventDispatcher_SubscribeAsync(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var d *AppEventDispatcher
+		_, _ = d.SubscribeAsync("event", func(AppEvent) {})
+	})
+
+	t.Run("nil listener", func(t *testing.T) {
+		if _, err := NewAppEventDispatcher().SubscribeAsync("event", nil); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'listener' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("register an asynchronous listener", func(t *testing.T) {
+		d := NewAppEventDispatcher()
+
+		if _, err := d.SubscribeAsync("event", func(AppEvent) {}); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if len(d.listeners["event"]) != 1 || !d.listeners["event"][0].async {
+			t.Error("didn't stored the asynchronous listener")
+		}
+	})
+}
+
+func Test_AppEventDispatcher_Unsubscribe(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var d *AppEventDispatcher
+		d.Unsubscribe(1)
+	})
+
+	t.Run("remove the requested listener", func(t *testing.T) {
+		d := NewAppEventDispatcher()
+
+		calls := []int{}
+		id1, _ := d.Subscribe("event", func(AppEvent) { calls = append(calls, 1) })
+		_, _ = d.Subscribe("event", func(AppEvent) { calls = append(calls, 2) })
+		d.Unsubscribe(id1)
+		_ = d.Dispatch(AppEvent{Name: "event"})
+
+		if !reflect.DeepEqual(calls, []int{2}) {
+			t.Errorf("called the (%v) listeners", calls)
+		}
+	})
+
+	t.Run("ignore a non-existing subscription", func(t *testing.T) {
+		d := NewAppEventDispatcher()
+		_, _ = d.Subscribe("event", func(AppEvent) {})
+		d.Unsubscribe(123)
+
+		if len(d.listeners["event"]) != 1 {
+			t.Error("removed a listener")
+		}
+	})
+}
+
+func Test_AppEventDispatcher_Dispatch(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var d *AppEventDispatcher
+		_ = d.Dispatch(AppEvent{Name: "event"})
+	})
+
+	t.Run("call the event listeners in subscription order", func(t *testing.T) {
+		d := NewAppEventDispatcher()
+
+		event := AppEvent{Name: "event", Data: "data"}
+		calls := []int{}
+		_, _ = d.Subscribe("event", func(e AppEvent) {
+			if !reflect.DeepEqual(e, event) {
+				t.Errorf("called with the (%v) event", e)
+			}
+			calls = append(calls, 1)
+		})
+		_, _ = d.Subscribe("other", func(AppEvent) { calls = append(calls, 2) })
+		_, _ = d.Subscribe(AppEventAll, func(AppEvent) { calls = append(calls, 3) })
+		_, _ = d.Subscribe("event", func(AppEvent) { calls = append(calls, 4) })
+
+		if err := d.Dispatch(event); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !reflect.DeepEqual(calls, []int{1, 4, 3}) {
+			t.Errorf("called the (%v) listeners", calls)
+		}
+	})
+
+	t.Run("isolate the listeners panics", func(t *testing.T) {
+		d := NewAppEventDispatcher()
+
+		calls := []int{}
+		_, _ = d.Subscribe("event", func(AppEvent) { panic("__dummy_panic__") })
+		_, _ = d.Subscribe("event", func(AppEvent) { calls = append(calls, 2) })
+		_, _ = d.Subscribe("event", func(AppEvent) { panic(errors.New("__dummy_error__")) })
+
+		if err := d.Dispatch(AppEvent{Name: "event"}); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !strings.Contains(err.Error(), "__dummy_panic__") || !strings.Contains(err.Error(), "__dummy_error__") {
+			t.Errorf("returned the (%v) error", err)
+		} else if !reflect.DeepEqual(calls, []int{2}) {
+			t.Errorf("called the (%v) listeners", calls)
+		}
+	})
+
+	t.Run("call the asynchronous listeners", func(t *testing.T) {
+		d := NewAppEventDispatcher()
+
+		result := make(chan AppEvent, 1)
+		_, _ = d.SubscribeAsync("event", func(AppEvent) { panic("__dummy_panic__") })
+		_, _ = d.SubscribeAsync("event", func(e AppEvent) { result <- e })
+
+		if err := d.Dispatch(AppEvent{Name: "event"}); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		}
+
+		select {
+		case e := <-result:
+			if e.Name != "event" {
+				t.Errorf("called with the (%v) event", e)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Error("didn't called the asynchronous listener")
+		}
+		d.Close()
+	})
+}
@@ -0,0 +1,5 @@
+package servlet
+
+// AppEventListener is a callback function used to be called when an event
+// subscribed by the listener is dispatched.
+type AppEventListener func(AppEvent)
@@ -0,0 +1,5 @@
+package servlet
+
+// AppProviderConstructor defines a function used to instantiate a provider
+// from the parameters defined in the provider configuration sub-tree.
+type AppProviderConstructor func(params ConfigPartial) (AppProvider, error)
@@ -0,0 +1,12 @@
+package servlet
+
+// AppProviderDependent is an interface used to define the methods of an
+// application provider that declares its dependencies. The provided values
+// are the container entry ids (or any other label used to identify the
+// provider) that the provider registers, and the required values are the
+// ids/labels that must be supplied by other providers, or already
+// registered in the container, prior to the provider registration and boot.
+type AppProviderDependent interface {
+	Provides() []string
+	Requires() []string
+}
@@ -0,0 +1,119 @@
+package servlet
+
+import (
+	"fmt"
+	"sync"
+)
+
+// AppProviderRegistry defines a storage of named provider constructors used
+// to instantiate the providers enabled by the application configuration.
+type AppProviderRegistry struct {
+	mutex        sync.Locker
+	constructors map[string]AppProviderConstructor
+}
+
+// NewAppProviderRegistry will instantiate a new provider registry with the
+// servlet file system, log, service and trigger providers constructors
+// registered.
+func NewAppProviderRegistry() *AppProviderRegistry {
+	r := &AppProviderRegistry{
+		mutex:        &sync.Mutex{},
+		constructors: map[string]AppProviderConstructor{},
+	}
+
+	_ = r.Register(AppProviderFileSystem, func(conf ConfigPartial) (AppProvider, error) {
+		params, err := NewFileSystemProviderParamsConfig(conf)
+		if err != nil {
+			return nil, err
+		}
+		return NewFileSystemProvider(params), nil
+	})
+
+	_ = r.Register(AppProviderLog, func(conf ConfigPartial) (AppProvider, error) {
+		params, err := NewLogProviderParamsConfig(conf)
+		if err != nil {
+			return nil, err
+		}
+		return NewLogProvider(params), nil
+	})
+
+	_ = r.Register(AppProviderService, func(conf ConfigPartial) (AppProvider, error) {
+		params, err := NewServiceProviderParamsConfig(conf)
+		if err != nil {
+			return nil, err
+		}
+		return NewServiceProvider(params), nil
+	})
+
+	_ = r.Register(AppProviderTrigger, func(conf ConfigPartial) (AppProvider, error) {
+		params, err := NewTriggerProviderParamsConfig(conf)
+		if err != nil {
+			return nil, err
+		}
+		return NewTriggerProvider(params), nil
+	})
+
+	return r
+}
+
+// Register will store a provider constructor with the given name. If a
+// constructor was previously registered with the same name, it will be
+// replaced.
+func (r *AppProviderRegistry) Register(name string, constructor AppProviderConstructor) error {
+	if r == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	if constructor == nil {
+		return fmt.Errorf("invalid nil 'constructor' argument")
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.constructors[name] = constructor
+	return nil
+}
+
+// Has will check if a provider constructor was registered with the
+// given name.
+func (r *AppProviderRegistry) Has(name string) bool {
+	if r == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	_, ok := r.constructors[name]
+	return ok
+}
+
+// Create will instantiate a provider by calling the constructor registered
+// with the given name with the given parameters.
+func (r *AppProviderRegistry) Create(name string, params ConfigPartial) (AppProvider, error) {
+	if r == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	r.mutex.Lock()
+	constructor, ok := r.constructors[name]
+	r.mutex.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("provider '%s' not registered", name)
+	}
+
+	if params == nil {
+		params = ConfigPartial{}
+	}
+
+	provider, err := constructor(params)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return nil, fmt.Errorf("provider '%s' constructor returned a nil provider", name)
+	}
+	return provider, nil
+}
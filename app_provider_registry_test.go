@@ -0,0 +1,227 @@
+package servlet
+
+import (
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"strings"
+	"testing"
+)
+
+func Test_NewAppProviderRegistry(t *testing.T) {
+	t.Run("register the servlet providers constructors", func(t *testing.T) {
+		registry := NewAppProviderRegistry()
+
+		for _, name := range []string{AppProviderFileSystem, AppProviderLog, AppProviderService, AppProviderTrigger} {
+			if !registry.Has(name) {
+				t.Errorf("didn't registered the (%v) provider constructor", name)
+			}
+		}
+	})
+
+	t.Run("create the servlet providers", func(t *testing.T) {
+		scenarios := []struct {
+			name   string
+			params ConfigPartial
+			check  func(provider AppProvider) bool
+		}{
+			{ // test file system provider creation
+				name:   AppProviderFileSystem,
+				params: ConfigPartial{"file_system_id": "fs"},
+				check: func(provider AppProvider) bool {
+					return provider.(*FileSystemProvider).params.FileSystemID == "fs"
+				},
+			},
+			{ // test log provider creation
+				name:   AppProviderLog,
+				params: ConfigPartial{"logger_id": "log"},
+				check: func(provider AppProvider) bool {
+					return provider.(*LogProvider).params.LoggerID == "log"
+				},
+			},
+			{ // test service provider creation
+				name:   AppProviderService,
+				params: ConfigPartial{"loader_id": "loader"},
+				check: func(provider AppProvider) bool {
+					return provider.(*ServiceProvider).params.LoaderID == "loader"
+				},
+			},
+			{ // test trigger provider creation
+				name:   AppProviderTrigger,
+				params: ConfigPartial{"scheduler_id": "scheduler"},
+				check: func(provider AppProvider) bool {
+					return provider.(*TriggerProvider).params.SchedulerID == "scheduler"
+				},
+			},
+		}
+
+		for _, scn := range scenarios {
+			if provider, err := NewAppProviderRegistry().Create(scn.name, scn.params); err != nil {
+				t.Errorf("returned the (%v) error", err)
+			} else if !scn.check(provider) {
+				t.Errorf("didn't created the (%v) provider with the given parameters", scn.name)
+			}
+		}
+	})
+
+	t.Run("error on invalid servlet providers parameters", func(t *testing.T) {
+		for _, name := range []string{AppProviderFileSystem, AppProviderLog, AppProviderService, AppProviderTrigger} {
+			params := ConfigPartial{"file_system_id": 123, "logger_id": 123, "loader_id": 123, "scheduler_id": 123}
+			if provider, err := NewAppProviderRegistry().Create(name, params); provider != nil {
+				t.Error("returned a valid reference")
+			} else if err == nil {
+				t.Error("didn't returned the expected error")
+			} else if !strings.HasPrefix(err.Error(), "interface conversion") {
+				t.Errorf("returned the (%v) error", err)
+			}
+		}
+	})
+}
+
+func Test_AppProviderRegistry_Register(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var registry *AppProviderRegistry
+		_ = registry.Register("name", func(ConfigPartial) (AppProvider, error) { return nil, nil })
+	})
+
+	t.Run("nil constructor", func(t *testing.T) {
+		registry := NewAppProviderRegistry()
+		if err := registry.Register("name", nil); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'constructor' argument" {
+			t.Errorf("returned the (%v) error", err)
+		} else if registry.Has("name") {
+			t.Error("stored the nil constructor")
+		}
+	})
+
+	t.Run("register the constructor", func(t *testing.T) {
+		registry := NewAppProviderRegistry()
+		if err := registry.Register("name", func(ConfigPartial) (AppProvider, error) { return nil, nil }); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !registry.Has("name") {
+			t.Error("didn't stored the constructor")
+		}
+	})
+}
+
+func Test_AppProviderRegistry_Has(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var registry *AppProviderRegistry
+		_ = registry.Has("name")
+	})
+
+	t.Run("check the constructor existence", func(t *testing.T) {
+		if NewAppProviderRegistry().Has("name") {
+			t.Error("found a non-registered constructor")
+		}
+	})
+}
+
+func Test_AppProviderRegistry_Create(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var registry *AppProviderRegistry
+		_, _ = registry.Create("name", nil)
+	})
+
+	t.Run("non-registered constructor", func(t *testing.T) {
+		if provider, err := NewAppProviderRegistry().Create("name", nil); provider != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "provider 'name' not registered" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("return the constructor error", func(t *testing.T) {
+		expected := fmt.Errorf("error")
+
+		registry := NewAppProviderRegistry()
+		_ = registry.Register("name", func(ConfigPartial) (AppProvider, error) { return nil, expected })
+
+		if provider, err := registry.Create("name", nil); provider != nil {
+			t.Error("returned a valid reference")
+		} else if err != expected {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error on nil constructed provider", func(t *testing.T) {
+		registry := NewAppProviderRegistry()
+		_ = registry.Register("name", func(ConfigPartial) (AppProvider, error) { return nil, nil })
+
+		if provider, err := registry.Create("name", nil); provider != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "provider 'name' constructor returned a nil provider" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("create the provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := NewMockAppProvider(ctrl)
+		params := ConfigPartial{"field": "value"}
+
+		registry := NewAppProviderRegistry()
+		_ = registry.Register("name", func(conf ConfigPartial) (AppProvider, error) {
+			if conf.String("field") != "value" {
+				t.Errorf("called the constructor with the (%v) params", conf)
+			}
+			return expected, nil
+		})
+
+		if provider, err := registry.Create("name", params); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if provider != expected {
+			t.Error("didn't returned the constructed provider")
+		}
+	})
+
+	t.Run("create the provider with empty params", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		registry := NewAppProviderRegistry()
+		_ = registry.Register("name", func(conf ConfigPartial) (AppProvider, error) {
+			if conf == nil {
+				t.Error("called the constructor with nil params")
+			}
+			return NewMockAppProvider(ctrl), nil
+		})
+
+		if _, err := registry.Create("name", nil); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+}
@@ -0,0 +1,11 @@
+package servlet
+
+import "context"
+
+// AppProviderShutdown is an interface used to define the method of an
+// application provider that should execute some termination actions when
+// the application is shutting down. The given context will carry the
+// deadline of the application shutdown process.
+type AppProviderShutdown interface {
+	Shutdown(context.Context, *AppContainer) error
+}
@@ -0,0 +1,11 @@
+package servlet
+
+import "context"
+
+// AppRunner is an interface used to define the method of a background
+// process supervised by the application. The runner should execute until
+// the given context is cancelled, or return earlier with the execution
+// result.
+type AppRunner interface {
+	Run(context.Context) error
+}
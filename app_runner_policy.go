@@ -0,0 +1,40 @@
+package servlet
+
+import "time"
+
+// AppRunnerRestart identifies a value type that describes when a
+// supervised runner should be restarted after its termination.
+type AppRunnerRestart int
+
+const (
+	// AppRunnerRestartNever defines that the runner is never restarted.
+	AppRunnerRestartNever AppRunnerRestart = iota
+	// AppRunnerRestartOnFailure defines that the runner is restarted only
+	// if it terminated with an error (or panic).
+	AppRunnerRestartOnFailure
+	// AppRunnerRestartAlways defines that the runner is always restarted.
+	AppRunnerRestartAlways
+)
+
+// AppRunnerPolicy defines the restart policy of a supervised runner.
+// The Backoff value is the delay between a runner termination and its
+// restart, that is doubled on every consecutive failure until reaching
+// the MaxBackoff value (if defined). The delay is never lower than the
+// AppRunnerMinBackoff value.
+type AppRunnerPolicy struct {
+	Restart    AppRunnerRestart
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+func (p AppRunnerPolicy) delay(failures int) time.Duration {
+	base := p.Backoff
+	if base < AppRunnerMinBackoff {
+		base = AppRunnerMinBackoff
+	}
+
+	if delay := backoff(base, p.MaxBackoff, failures); delay > AppRunnerMinBackoff {
+		return delay
+	}
+	return AppRunnerMinBackoff
+}
@@ -0,0 +1,58 @@
+package servlet
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_AppRunnerPolicy_delay(t *testing.T) {
+	t.Run("calculate the restart delay", func(t *testing.T) {
+		scenarios := []struct {
+			policy   AppRunnerPolicy
+			failures int
+			expected time.Duration
+		}{
+			{ // test restart without failures
+				policy:   AppRunnerPolicy{Backoff: time.Second, MaxBackoff: time.Minute},
+				failures: 0,
+				expected: time.Second,
+			},
+			{ // test restart after a single failure
+				policy:   AppRunnerPolicy{Backoff: time.Second, MaxBackoff: time.Minute},
+				failures: 1,
+				expected: time.Second,
+			},
+			{ // test restart after consecutive failures
+				policy:   AppRunnerPolicy{Backoff: time.Second, MaxBackoff: time.Minute},
+				failures: 4,
+				expected: 8 * time.Second,
+			},
+			{ // test restart delay capping
+				policy:   AppRunnerPolicy{Backoff: time.Second, MaxBackoff: 5 * time.Second},
+				failures: 100,
+				expected: 5 * time.Second,
+			},
+			{ // test restart without backoff
+				policy:   AppRunnerPolicy{},
+				failures: 1,
+				expected: AppRunnerMinBackoff,
+			},
+			{ // test restart with a backoff lower than the minimum
+				policy:   AppRunnerPolicy{Backoff: time.Millisecond, MaxBackoff: time.Millisecond},
+				failures: 3,
+				expected: AppRunnerMinBackoff,
+			},
+			{ // test restart delay without cap
+				policy:   AppRunnerPolicy{Backoff: time.Second},
+				failures: 6,
+				expected: 32 * time.Second,
+			},
+		}
+
+		for _, scn := range scenarios {
+			if delay := scn.policy.delay(scn.failures); delay != scn.expected {
+				t.Errorf("returned the (%v) delay for (%d) failures", delay, scn.failures)
+			}
+		}
+	})
+}
@@ -0,0 +1,219 @@
+package servlet
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+type appSupervisorRefRunner struct {
+	id     string
+	runner AppRunner
+	policy AppRunnerPolicy
+}
+
+// AppSupervisor defines the instance used to manage the background runners
+// of the application. The runners are started after the application boot,
+// and cancelled when the application shuts down.
+type AppSupervisor struct {
+	mutex     sync.Locker
+	container *AppContainer
+	loggerID  string
+	logger    *Log
+	runners   []appSupervisorRefRunner
+	ctx       context.Context
+	cancel    context.CancelFunc
+	running   *sync.WaitGroup
+}
+
+// NewAppSupervisor instantiate a new runner supervisor. The given container
+// is used to retrieve the logger used to report the runners terminations.
+func NewAppSupervisor(container *AppContainer) (*AppSupervisor, error) {
+	if container == nil {
+		return nil, fmt.Errorf("invalid nil 'container' argument")
+	}
+
+	s := &AppSupervisor{
+		mutex:     &sync.Mutex{},
+		container: container,
+		loggerID:  ContainerLoggerID,
+		logger:    nil,
+		runners:   []appSupervisorRefRunner{},
+		ctx:       nil,
+		cancel:    nil,
+		running:   &sync.WaitGroup{},
+	}
+
+	if env := os.Getenv(EnvContainerLoggerID); env != "" {
+		s.loggerID = env
+	}
+
+	return s, nil
+}
+
+// Close will stop all the running runners.
+func (s *AppSupervisor) Close() {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	s.Stop()
+}
+
+// Has will check if a runner is registered with the requested id.
+func (s *AppSupervisor) Has(id string) bool {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, ref := range s.runners {
+		if ref.id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Add will register a new runner with the requested id and restart policy.
+// If the supervisor is already running, the runner will be started
+// immediately.
+func (s *AppSupervisor) Add(id string, runner AppRunner, policy AppRunnerPolicy) error {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	if runner == nil {
+		return fmt.Errorf("invalid nil 'runner' argument")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, ref := range s.runners {
+		if ref.id == id {
+			return fmt.Errorf("duplicate runner id : %s", id)
+		}
+	}
+
+	ref := appSupervisorRefRunner{id, runner, policy}
+	s.runners = append(s.runners, ref)
+
+	if s.cancel != nil {
+		s.running.Add(1)
+		go s.supervise(s.ctx, ref)
+	}
+	return nil
+}
+
+// IsRunning check if the supervisor runners have been started.
+func (s *AppSupervisor) IsRunning() bool {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.cancel != nil
+}
+
+// Start will start the execution of all the registered runners.
+func (s *AppSupervisor) Start() {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.cancel != nil {
+		return
+	}
+
+	s.logger = nil
+	if s.container.Has(s.loggerID) {
+		if logger, err := s.container.Get(s.loggerID); err == nil {
+			s.logger, _ = logger.(*Log)
+		}
+	}
+
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	for _, ref := range s.runners {
+		s.running.Add(1)
+		go s.supervise(s.ctx, ref)
+	}
+}
+
+// Stop will cancel the execution of all the runners and wait for
+// their termination.
+func (s *AppSupervisor) Stop() {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	s.mutex.Lock()
+	cancel := s.cancel
+	s.ctx, s.cancel = nil, nil
+	s.mutex.Unlock()
+
+	if cancel != nil {
+		cancel()
+		s.running.Wait()
+	}
+}
+
+func (s *AppSupervisor) supervise(ctx context.Context, ref appSupervisorRefRunner) {
+	defer s.running.Done()
+
+	failures := 0
+	for {
+		err := s.run(ctx, ref.runner)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			failures++
+			s.log(ERROR, fmt.Sprintf("runner '%s' terminated with error", ref.id), map[string]interface{}{"runner": ref.id, "error": err.Error()})
+		} else {
+			failures = 0
+			s.log(NOTICE, fmt.Sprintf("runner '%s' terminated", ref.id), map[string]interface{}{"runner": ref.id})
+		}
+
+		switch {
+		case ref.policy.Restart == AppRunnerRestartNever,
+			ref.policy.Restart == AppRunnerRestartOnFailure && err == nil:
+			return
+		}
+
+		delay := ref.policy.delay(failures)
+		s.log(NOTICE, fmt.Sprintf("runner '%s' restarting", ref.id), map[string]interface{}{"runner": ref.id, "delay": delay.String()})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (s *AppSupervisor) run(ctx context.Context, runner AppRunner) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("runner panic : %v", r)
+		}
+	}()
+
+	return runner.Run(ctx)
+}
+
+func (s *AppSupervisor) log(level LogLevel, message string, context map[string]interface{}) {
+	if s.logger != nil {
+		_ = s.logger.Broadcast(level, message, context)
+	}
+}
@@ -0,0 +1,388 @@
+package servlet
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_NewAppSupervisor(t *testing.T) {
+	t.Run("nil container", func(t *testing.T) {
+		if s, err := NewAppSupervisor(nil); s != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'container' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("new supervisor", func(t *testing.T) {
+		container := NewAppContainer()
+		if s, err := NewAppSupervisor(container); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if s == nil {
+			t.Error("didn't returned a valid reference")
+		} else if s.container != container {
+			t.Error("didn't stored the container")
+		} else if s.loggerID != ContainerLoggerID {
+			t.Errorf("stored the (%v) logger id", s.loggerID)
+		} else if s.IsRunning() {
+			t.Error("flagged the supervisor as running")
+		}
+	})
+
+	t.Run("with the env logger ID", func(t *testing.T) {
+		_ = os.Setenv(EnvContainerLoggerID, "logger_id")
+		defer func() { _ = os.Setenv(EnvContainerLoggerID, "") }()
+
+		if s, _ := NewAppSupervisor(NewAppContainer()); s.loggerID != "logger_id" {
+			t.Errorf("stored the (%v) logger id", s.loggerID)
+		}
+	})
+}
+
+func Test_AppSupervisor_Close(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var s *AppSupervisor
+		s.Close()
+	})
+
+	t.Run("stop the runners", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		runner := NewMockAppRunner(ctrl)
+		runner.EXPECT().Run(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+			<-ctx.Done()
+			return nil
+		}).Times(1)
+
+		s, _ := NewAppSupervisor(NewAppContainer())
+		_ = s.Add("id", runner, AppRunnerPolicy{})
+		s.Start()
+		s.Close()
+
+		if s.IsRunning() {
+			t.Error("didn't stopped the supervisor")
+		}
+	})
+}
+
+func Test_AppSupervisor_Add(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var s *AppSupervisor
+		_ = s.Add("id", nil, AppRunnerPolicy{})
+	})
+
+	t.Run("nil runner", func(t *testing.T) {
+		s, _ := NewAppSupervisor(NewAppContainer())
+		if err := s.Add("id", nil, AppRunnerPolicy{}); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'runner' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("duplicate runner id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, _ := NewAppSupervisor(NewAppContainer())
+		_ = s.Add("id", NewMockAppRunner(ctrl), AppRunnerPolicy{})
+
+		if err := s.Add("id", NewMockAppRunner(ctrl), AppRunnerPolicy{}); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "duplicate runner id : id" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("concurrent duplicate runner id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, _ := NewAppSupervisor(NewAppContainer())
+
+		added := int32(0)
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if s.Add("id", NewMockAppRunner(ctrl), AppRunnerPolicy{}) == nil {
+					atomic.AddInt32(&added, 1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if added != 1 {
+			t.Errorf("registered the runner (%d) times", added)
+		}
+	})
+
+	t.Run("register the runner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		s, _ := NewAppSupervisor(NewAppContainer())
+
+		if err := s.Add("id", NewMockAppRunner(ctrl), AppRunnerPolicy{}); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !s.Has("id") {
+			t.Error("didn't stored the runner")
+		}
+	})
+
+	t.Run("start the runner if the supervisor is running", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		started := make(chan bool, 1)
+		runner := NewMockAppRunner(ctrl)
+		runner.EXPECT().Run(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+			started <- true
+			<-ctx.Done()
+			return nil
+		}).Times(1)
+
+		s, _ := NewAppSupervisor(NewAppContainer())
+		s.Start()
+		defer s.Stop()
+
+		_ = s.Add("id", runner, AppRunnerPolicy{})
+
+		select {
+		case <-started:
+		case <-time.After(100 * time.Millisecond):
+			t.Error("didn't started the runner")
+		}
+	})
+}
+
+func Test_AppSupervisor_Start(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var s *AppSupervisor
+		s.Start()
+	})
+
+	t.Run("start the runners only once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		runner := NewMockAppRunner(ctrl)
+		runner.EXPECT().Run(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+			<-ctx.Done()
+			return nil
+		}).Times(1)
+
+		s, _ := NewAppSupervisor(NewAppContainer())
+		_ = s.Add("id", runner, AppRunnerPolicy{})
+		s.Start()
+		s.Start()
+		time.Sleep(20 * time.Millisecond)
+		s.Stop()
+	})
+
+	t.Run("don't restart a runner with the never policy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		runner := NewMockAppRunner(ctrl)
+		runner.EXPECT().Run(gomock.Any()).Return(fmt.Errorf("error")).Times(1)
+
+		s, _ := NewAppSupervisor(NewAppContainer())
+		_ = s.Add("id", runner, AppRunnerPolicy{Restart: AppRunnerRestartNever})
+		s.Start()
+		time.Sleep(20 * time.Millisecond)
+		s.Stop()
+	})
+
+	t.Run("restart a failing runner with the on failure policy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		runner := NewMockAppRunner(ctrl)
+		gomock.InOrder(
+			runner.EXPECT().Run(gomock.Any()).Return(fmt.Errorf("error")).Times(1),
+			runner.EXPECT().Run(gomock.Any()).DoAndReturn(func(context.Context) error {
+				panic("__dummy_panic__")
+			}).Times(1),
+			runner.EXPECT().Run(gomock.Any()).Return(nil).Times(1),
+		)
+
+		s, _ := NewAppSupervisor(NewAppContainer())
+		_ = s.Add("id", runner, AppRunnerPolicy{Restart: AppRunnerRestartOnFailure, Backoff: time.Millisecond})
+		s.Start()
+		time.Sleep(100 * time.Millisecond)
+		s.Stop()
+	})
+
+	t.Run("restart a runner with the always policy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		count := int32(0)
+		runner := NewMockAppRunner(ctrl)
+		runner.EXPECT().Run(gomock.Any()).DoAndReturn(func(context.Context) error {
+			atomic.AddInt32(&count, 1)
+			return nil
+		}).MinTimes(2)
+
+		s, _ := NewAppSupervisor(NewAppContainer())
+		_ = s.Add("id", runner, AppRunnerPolicy{Restart: AppRunnerRestartAlways, Backoff: time.Millisecond})
+		s.Start()
+		time.Sleep(50 * time.Millisecond)
+		s.Stop()
+
+		if atomic.LoadInt32(&count) < 2 {
+			t.Error("didn't restarted the runner")
+		}
+	})
+
+	t.Run("restart a runner without backoff with the minimum delay", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		count := int32(0)
+		runner := NewMockAppRunner(ctrl)
+		runner.EXPECT().Run(gomock.Any()).DoAndReturn(func(context.Context) error {
+			atomic.AddInt32(&count, 1)
+			return nil
+		}).MinTimes(1)
+
+		s, _ := NewAppSupervisor(NewAppContainer())
+		_ = s.Add("id", runner, AppRunnerPolicy{Restart: AppRunnerRestartAlways})
+		s.Start()
+		time.Sleep(5 * AppRunnerMinBackoff)
+		s.Stop()
+
+		if c := atomic.LoadInt32(&count); c > 6 {
+			t.Errorf("restarted the runner (%d) times", c)
+		}
+	})
+
+	t.Run("log the runner termination", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stream := NewMockLogStream(ctrl)
+		stream.EXPECT().Broadcast(ERROR, "runner 'id' terminated with error", map[string]interface{}{"runner": "id", "error": "error"}).Return(nil).Times(1)
+		stream.EXPECT().Close().Return(nil).AnyTimes()
+		logger := NewLog()
+		_ = logger.AddStream("stream", stream)
+
+		container := NewAppContainer()
+		_ = container.Add(ContainerLoggerID, func(*AppContainer) (interface{}, error) {
+			return logger, nil
+		})
+
+		runner := NewMockAppRunner(ctrl)
+		runner.EXPECT().Run(gomock.Any()).Return(fmt.Errorf("error")).Times(1)
+
+		s, _ := NewAppSupervisor(container)
+		_ = s.Add("id", runner, AppRunnerPolicy{Restart: AppRunnerRestartNever})
+		s.Start()
+		time.Sleep(20 * time.Millisecond)
+		s.Stop()
+	})
+}
+
+func Test_AppSupervisor_Stop(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var s *AppSupervisor
+		s.Stop()
+	})
+
+	t.Run("cancel the runners and wait for them", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		done := int32(0)
+		runner := NewMockAppRunner(ctrl)
+		runner.EXPECT().Run(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+			<-ctx.Done()
+			time.Sleep(10 * time.Millisecond)
+			atomic.StoreInt32(&done, 1)
+			return ctx.Err()
+		}).Times(1)
+
+		s, _ := NewAppSupervisor(NewAppContainer())
+		_ = s.Add("id", runner, AppRunnerPolicy{Restart: AppRunnerRestartAlways})
+		s.Start()
+		time.Sleep(10 * time.Millisecond)
+		s.Stop()
+
+		if atomic.LoadInt32(&done) != 1 {
+			t.Error("didn't waited for the runner termination")
+		} else if s.IsRunning() {
+			t.Error("didn't flagged the supervisor as stopped")
+		}
+	})
+}
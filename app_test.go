@@ -1,11 +1,30 @@
 package servlet
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"syscall"
 	"testing"
+	"time"
 )
 
+type appProviderDependent struct {
+	*MockAppProvider
+	*MockAppProviderDependent
+}
+
+type appProviderShutdown struct {
+	*MockAppProvider
+	*MockAppProviderShutdown
+}
+
 func Test_NewApp(t *testing.T) {
 	t.Run("instantiate a new app", func(t *testing.T) {
 		if NewApp() == nil {
@@ -30,6 +49,83 @@ func Test_NewApp(t *testing.T) {
 			t.Error("didn't flagged the app as not booted")
 		}
 	})
+
+	t.Run("instantiate a event dispatcher", func(t *testing.T) {
+		if NewApp().events == nil {
+			t.Error("didn't created the event dispatcher")
+		}
+	})
+
+	t.Run("register the event dispatcher in the container", func(t *testing.T) {
+		a := NewApp()
+		if events, err := a.container.Get(ContainerAppEventDispatcherID); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if events != a.events {
+			t.Error("didn't registered the app event dispatcher")
+		}
+	})
+
+	t.Run("register the runner supervisor in the container", func(t *testing.T) {
+		a := NewApp()
+		if supervisor, err := a.container.Get(ContainerAppSupervisorID); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if supervisor == nil || supervisor != a.supervisor {
+			t.Error("didn't registered the app runner supervisor")
+		}
+	})
+
+	t.Run("store the default shutdown timeout", func(t *testing.T) {
+		if timeout := NewApp().shutdownTimeout; timeout != AppShutdownTimeout {
+			t.Errorf("stored the (%v) shutdown timeout", timeout)
+		}
+	})
+
+	t.Run("with the env shutdown timeout", func(t *testing.T) {
+		_ = os.Setenv(EnvAppShutdownTimeout, "5")
+		defer func() { _ = os.Setenv(EnvAppShutdownTimeout, "") }()
+
+		if timeout := NewApp().shutdownTimeout; timeout != 5*time.Second {
+			t.Errorf("stored the (%v) shutdown timeout", timeout)
+		}
+	})
+
+	t.Run("instantiate a provider registry", func(t *testing.T) {
+		if registry := NewApp().registry; registry == nil {
+			t.Error("didn't created the provider registry")
+		} else if !registry.Has(AppProviderLog) {
+			t.Error("didn't registered the servlet providers constructors")
+		}
+	})
+
+	t.Run("with the env config ID", func(t *testing.T) {
+		_ = os.Setenv(EnvContainerConfigID, "config_id")
+		defer func() { _ = os.Setenv(EnvContainerConfigID, "") }()
+
+		if id := NewApp().configID; id != "config_id" {
+			t.Errorf("stored the (%v) config ID", id)
+		}
+	})
+
+	t.Run("store the default warm-up flag", func(t *testing.T) {
+		if a := NewApp(); a.warmUp != AppWarmUp {
+			t.Errorf("stored the (%v) warm-up flag", a.warmUp)
+		} else if len(a.warmUpTags) != 0 {
+			t.Errorf("stored the (%v) warm-up tags", a.warmUpTags)
+		}
+	})
+
+	t.Run("with the env warm-up flag and tags", func(t *testing.T) {
+		_ = os.Setenv(EnvAppWarmUp, "true")
+		defer func() { _ = os.Setenv(EnvAppWarmUp, "") }()
+		_ = os.Setenv(EnvAppWarmUpTags, "tag1, ,tag2")
+		defer func() { _ = os.Setenv(EnvAppWarmUpTags, "") }()
+
+		if a := NewApp(); !a.warmUp {
+			t.Error("didn't stored the warm-up flag")
+		} else if !reflect.DeepEqual(a.warmUpTags, []string{"tag1", "tag2"}) {
+			t.Errorf("stored the (%v) warm-up tags", a.warmUpTags)
+		}
+	})
 }
 
 func Test_App_Container(t *testing.T) {
@@ -41,6 +137,99 @@ func Test_App_Container(t *testing.T) {
 	})
 }
 
+func Test_App_Registry(t *testing.T) {
+	t.Run("retrieve the stored provider registry", func(t *testing.T) {
+		a := NewApp()
+		if a.Registry() != a.registry {
+			t.Error("didn't returned the stored provider registry")
+		}
+	})
+}
+
+func Test_App_Events(t *testing.T) {
+	t.Run("retrieve the stored event dispatcher", func(t *testing.T) {
+		a := NewApp()
+		if a.Events() != a.events {
+			t.Error("didn't returned the stored event dispatcher")
+		}
+	})
+}
+
+func Test_App_Supervisor(t *testing.T) {
+	t.Run("retrieve the stored runner supervisor", func(t *testing.T) {
+		a := NewApp()
+		if a.Supervisor() != a.supervisor {
+			t.Error("didn't returned the stored runner supervisor")
+		}
+	})
+}
+
+func Test_App_ShutdownTimeout(t *testing.T) {
+	t.Run("retrieve the stored shutdown timeout", func(t *testing.T) {
+		a := NewApp()
+		a.shutdownTimeout = time.Minute
+		if a.ShutdownTimeout() != time.Minute {
+			t.Error("didn't returned the stored shutdown timeout")
+		}
+	})
+}
+
+func Test_App_SetWarmUp(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else if r.(error).Error() != "nil pointer receiver" {
+				t.Errorf("panic with the (%v) error", r)
+			}
+		}()
+
+		var a *App
+		a.SetWarmUp(true)
+	})
+
+	t.Run("store the warm-up flag and tags", func(t *testing.T) {
+		a := NewApp()
+		a.SetWarmUp(true, "tag1", "tag2")
+
+		if !a.warmUp {
+			t.Error("didn't stored the warm-up flag")
+		} else if !reflect.DeepEqual(a.warmUpTags, []string{"tag1", "tag2"}) {
+			t.Errorf("stored the (%v) warm-up tags", a.warmUpTags)
+		}
+	})
+}
+
+func Test_App_SetShutdownTimeout(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var a *App
+		a.SetShutdownTimeout(time.Second)
+	})
+
+	t.Run("store the shutdown timeout", func(t *testing.T) {
+		a := NewApp()
+		a.SetShutdownTimeout(time.Minute)
+		if a.shutdownTimeout != time.Minute {
+			t.Errorf("stored the (%v) shutdown timeout", a.shutdownTimeout)
+		}
+	})
+}
+
 func Test_App_Add(t *testing.T) {
 	t.Run("nil pointer receiver", func(t *testing.T) {
 		defer func() {
@@ -75,39 +264,78 @@ func Test_App_Add(t *testing.T) {
 		}
 	})
 
-	t.Run("error registering provider", func(t *testing.T) {
+	t.Run("adding a valid provider", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		a := NewApp()
 
-		expectedError := "error"
+		p := NewMockAppProvider(ctrl)
+
+		if err := a.Add(p); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if a.providers[0] != p {
+			t.Error("didn't stored the added provider")
+		}
+	})
+
+	t.Run("register and boot a provider added to a booted app", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		_ = a.Boot()
+
+		p := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		gomock.InOrder(
+			p.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1),
+			p.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1),
+			p.MockAppProviderShutdown.EXPECT().Shutdown(gomock.Any(), a.container).Return(nil).Times(1),
+		)
+
+		if err := a.Add(p); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if err := a.Shutdown(context.Background()); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error registering a provider added to a booted app", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		_ = a.Boot()
 
 		p := NewMockAppProvider(ctrl)
-		p.EXPECT().Register(a.container).Return(fmt.Errorf(expectedError)).Times(1)
+		p.EXPECT().Register(a.container).Return(fmt.Errorf("error")).Times(1)
 
 		if err := a.Add(p); err == nil {
 			t.Error("didn't returned the expected error")
-		} else if err.Error() != expectedError {
+		} else if err.Error() != "provider '*servlet.MockAppProvider' register error : error" {
 			t.Errorf("returned the (%v) error", err)
 		} else if len(a.providers) != 0 {
-			t.Error("stored the failing provider")
+			t.Error("stored the provider")
 		}
 	})
 
-	t.Run("adding a valid provider", func(t *testing.T) {
+	t.Run("error booting a provider added to a booted app", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		a := NewApp()
+		_ = a.Boot()
 
 		p := NewMockAppProvider(ctrl)
 		p.EXPECT().Register(a.container).Return(nil).Times(1)
+		p.EXPECT().Boot(a.container).Return(fmt.Errorf("error")).Times(1)
 
-		if err := a.Add(p); err != nil {
+		if err := a.Add(p); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "provider '*servlet.MockAppProvider' boot error : error" {
 			t.Errorf("returned the (%v) error", err)
-		} else if a.providers[0] != p {
-			t.Error("didn't stored the added provider")
+		} else if len(a.providers) != 0 {
+			t.Error("stored the provider")
 		}
 	})
 }
@@ -133,6 +361,26 @@ func Test_App_Boot(t *testing.T) {
 		_ = a.Boot()
 	})
 
+	t.Run("error registering provider", func(t *testing.T) {
+		expectedError := "error"
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		p := NewMockAppProvider(ctrl)
+		p.EXPECT().Register(a.container).Return(fmt.Errorf(expectedError)).Times(1)
+		_ = a.Add(p)
+
+		if err := a.Boot(); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "provider '*servlet.MockAppProvider' register error : "+expectedError {
+			t.Errorf("returned the (%v) error", err)
+		} else if a.boot {
+			t.Error("flagged the app as booted")
+		}
+	})
+
 	t.Run("error on boot", func(t *testing.T) {
 		expectedError := "error"
 
@@ -147,7 +395,7 @@ func Test_App_Boot(t *testing.T) {
 
 		if err := a.Boot(); err == nil {
 			t.Error("didn't returned the expected error")
-		} else if err.Error() != expectedError {
+		} else if err.Error() != "provider '*servlet.MockAppProvider' boot error : "+expectedError {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -170,3 +418,892 @@ func Test_App_Boot(t *testing.T) {
 		}
 	})
 }
+
+func Test_App_Boot_Rollback(t *testing.T) {
+	t.Run("close the container on register error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		p1 := NewMockAppProvider(ctrl)
+		p1.EXPECT().Register(a.container).DoAndReturn(func(c *AppContainer) error {
+			return c.Add("id", func(*AppContainer) (interface{}, error) {
+				return "value", nil
+			})
+		}).Times(1)
+		p2 := NewMockAppProvider(ctrl)
+		p2.EXPECT().Register(a.container).Return(fmt.Errorf("error")).Times(1)
+		_ = a.Add(p1)
+		_ = a.Add(p2)
+
+		if err := a.Boot(); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if a.container.Has("id") {
+			t.Error("didn't closed the container")
+		}
+	})
+
+	t.Run("shutdown the booted providers in reverse order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expectedError := fmt.Errorf("error")
+
+		a := NewApp()
+		p1 := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		p1.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1)
+		p2 := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		p2.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1)
+		p3 := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		p3.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1)
+		entry := NewMockClosable(ctrl)
+		gomock.InOrder(
+			p1.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1),
+			p2.MockAppProvider.EXPECT().Boot(a.container).DoAndReturn(func(c *AppContainer) error {
+				_ = c.Add("id", func(*AppContainer) (interface{}, error) {
+					return entry, nil
+				})
+				_, _ = c.Get("id")
+				return nil
+			}).Times(1),
+			p3.MockAppProvider.EXPECT().Boot(a.container).Return(expectedError).Times(1),
+			p2.MockAppProviderShutdown.EXPECT().Shutdown(gomock.Any(), a.container).Return(nil).Times(1),
+			p1.MockAppProviderShutdown.EXPECT().Shutdown(gomock.Any(), a.container).Return(nil).Times(1),
+			entry.EXPECT().Close().Times(1),
+		)
+		_ = a.Add(p1)
+		_ = a.Add(p2)
+		_ = a.Add(p3)
+
+		if err := a.Boot(); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, expectedError) {
+			t.Errorf("returned the (%v) error", err)
+		} else if err.Error() != "provider 'servlet.appProviderShutdown' boot error : error" {
+			t.Errorf("returned the (%v) error", err)
+		} else if a.boot {
+			t.Error("flagged the app as booted")
+		}
+	})
+
+	t.Run("report the rollback errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expectedError := fmt.Errorf("boot error")
+		expectedRollbackError := fmt.Errorf("shutdown error")
+
+		a := NewApp()
+		p1 := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		p1.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1)
+		p1.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1)
+		p1.MockAppProviderShutdown.EXPECT().Shutdown(gomock.Any(), a.container).Return(expectedRollbackError).Times(1)
+		p2 := NewMockAppProvider(ctrl)
+		p2.EXPECT().Register(a.container).Return(nil).Times(1)
+		p2.EXPECT().Boot(a.container).Return(expectedError).Times(1)
+		_ = a.Add(p1)
+		_ = a.Add(p2)
+
+		if err := a.Boot(); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, expectedError) || !errors.Is(err, expectedRollbackError) {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+}
+
+func Test_App_Boot_ConfiguredProviders(t *testing.T) {
+	config := func(ctrl *gomock.Controller, providers ...interface{}) *Config {
+		source := NewMockConfigSource(ctrl)
+		source.EXPECT().Get("").Return(ConfigPartial{"providers": providers}).AnyTimes()
+		source.EXPECT().Close().AnyTimes()
+
+		config, _ := NewConfig(0 * time.Second)
+		_ = config.AddSource("source", 0, source)
+		return config
+	}
+
+	t.Run("register and boot the configured providers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		p1 := NewMockAppProvider(ctrl)
+		p2 := NewMockAppProvider(ctrl)
+		p3 := NewMockAppProvider(ctrl)
+		gomock.InOrder(
+			p1.EXPECT().Register(a.container).DoAndReturn(func(c *AppContainer) error {
+				return c.Add(ContainerConfigID, func(*AppContainer) (interface{}, error) {
+					return config(ctrl, "first", ConfigPartial{"name": "second", "params": ConfigPartial{"field": "value"}}), nil
+				})
+			}).Times(1),
+			p1.EXPECT().Boot(a.container).Return(nil).Times(1),
+			p2.EXPECT().Register(a.container).Return(nil).Times(1),
+			p3.EXPECT().Register(a.container).Return(nil).Times(1),
+			p2.EXPECT().Boot(a.container).Return(nil).Times(1),
+			p3.EXPECT().Boot(a.container).Return(nil).Times(1),
+		)
+		_ = a.Add(p1)
+		_ = a.registry.Register("first", func(conf ConfigPartial) (AppProvider, error) {
+			if len(conf) != 0 {
+				t.Errorf("called the constructor with the (%v) params", conf)
+			}
+			return p2, nil
+		})
+		_ = a.registry.Register("second", func(conf ConfigPartial) (AppProvider, error) {
+			if conf.String("field") != "value" {
+				t.Errorf("called the constructor with the (%v) params", conf)
+			}
+			return p3, nil
+		})
+
+		if err := a.Boot(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !reflect.DeepEqual(a.providers, []AppProvider{p1, p2, p3}) {
+			t.Errorf("stored the (%v) providers", a.providers)
+		}
+	})
+
+	t.Run("rollback on invalid providers configuration", func(t *testing.T) {
+		scenarios := []struct {
+			providers []interface{}
+			expected  string
+		}{
+			{ // test non-registered provider
+				providers: []interface{}{"unknown"},
+				expected:  "application providers configuration error : provider 'unknown' not registered",
+			},
+			{ // test invalid provider entry
+				providers: []interface{}{123},
+				expected:  "application providers configuration error : interface conversion",
+			},
+			{ // test invalid provider params
+				providers: []interface{}{ConfigPartial{"name": AppProviderLog, "params": "string"}},
+				expected:  "application providers configuration error : interface conversion",
+			},
+		}
+
+		for _, scn := range scenarios {
+			ctrl := gomock.NewController(t)
+
+			a := NewApp()
+			p := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+			p.MockAppProvider.EXPECT().Register(a.container).DoAndReturn(func(c *AppContainer) error {
+				return c.Add(ContainerConfigID, func(*AppContainer) (interface{}, error) {
+					return config(ctrl, scn.providers...), nil
+				})
+			}).Times(1)
+			p.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1)
+			p.MockAppProviderShutdown.EXPECT().Shutdown(gomock.Any(), a.container).Return(nil).Times(1)
+			_ = a.Add(p)
+
+			if err := a.Boot(); err == nil {
+				t.Error("didn't returned the expected error")
+			} else if !strings.HasPrefix(err.Error(), scn.expected) {
+				t.Errorf("returned the (%v) error", err)
+			} else if a.boot {
+				t.Error("flagged the app as booted")
+			}
+
+			ctrl.Finish()
+		}
+	})
+
+	t.Run("rollback all the booted providers on configured provider boot error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expectedError := fmt.Errorf("error")
+
+		a := NewApp()
+		p1 := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		p2 := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		p3 := NewMockAppProvider(ctrl)
+		gomock.InOrder(
+			p1.MockAppProvider.EXPECT().Register(a.container).DoAndReturn(func(c *AppContainer) error {
+				return c.Add(ContainerConfigID, func(*AppContainer) (interface{}, error) {
+					return config(ctrl, "second", "third"), nil
+				})
+			}).Times(1),
+			p1.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1),
+			p2.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1),
+			p3.EXPECT().Register(a.container).Return(nil).Times(1),
+			p2.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1),
+			p3.EXPECT().Boot(a.container).Return(expectedError).Times(1),
+			p2.MockAppProviderShutdown.EXPECT().Shutdown(gomock.Any(), a.container).Return(nil).Times(1),
+			p1.MockAppProviderShutdown.EXPECT().Shutdown(gomock.Any(), a.container).Return(nil).Times(1),
+		)
+		_ = a.Add(p1)
+		_ = a.registry.Register("second", func(ConfigPartial) (AppProvider, error) { return p2, nil })
+		_ = a.registry.Register("third", func(ConfigPartial) (AppProvider, error) { return p3, nil })
+
+		if err := a.Boot(); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, expectedError) {
+			t.Errorf("returned the (%v) error", err)
+		} else if a.boot {
+			t.Error("flagged the app as booted")
+		}
+	})
+}
+
+func Test_App_Boot_WarmUp(t *testing.T) {
+	t.Run("don't instantiate the container entries if not enabled", func(t *testing.T) {
+		a := NewApp()
+		count := 0
+		_ = a.container.Add("id", func(*AppContainer) (interface{}, error) {
+			count++
+			return "value", nil
+		})
+
+		if err := a.Boot(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if count != 0 {
+			t.Error("instantiated the container entry")
+		}
+	})
+
+	t.Run("instantiate the container entries after the providers boot", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		a.SetWarmUp(true)
+
+		count := 0
+		p := NewMockAppProvider(ctrl)
+		p.EXPECT().Register(a.container).DoAndReturn(func(c *AppContainer) error {
+			return c.Add("id", func(*AppContainer) (interface{}, error) {
+				count++
+				return "value", nil
+			})
+		}).Times(1)
+		p.EXPECT().Boot(a.container).DoAndReturn(func(*AppContainer) error {
+			if count != 0 {
+				t.Error("instantiated the container entry prior the provider boot")
+			}
+			return nil
+		}).Times(1)
+		_ = a.Add(p)
+
+		if err := a.Boot(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if count != 1 {
+			t.Errorf("instantiated the container entry (%d) times", count)
+		}
+	})
+
+	t.Run("instantiate only the tagged container entries", func(t *testing.T) {
+		a := NewApp()
+		a.SetWarmUp(true, "tag")
+
+		tagged := false
+		_ = a.container.Add("id1", func(*AppContainer) (interface{}, error) {
+			tagged = true
+			return "value", nil
+		}, "tag")
+		_ = a.container.Add("id2", func(*AppContainer) (interface{}, error) {
+			return nil, fmt.Errorf("error")
+		})
+
+		if err := a.Boot(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !tagged {
+			t.Error("didn't instantiated the tagged container entry")
+		}
+	})
+
+	t.Run("report all the warm-up errors and rollback", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		a.SetWarmUp(true)
+
+		p := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		p.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1)
+		p.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1)
+		p.MockAppProviderShutdown.EXPECT().Shutdown(gomock.Any(), a.container).Return(nil).Times(1)
+		_ = a.Add(p)
+
+		_ = a.container.Add("id1", func(*AppContainer) (interface{}, error) {
+			return nil, fmt.Errorf("error 1")
+		})
+		_ = a.container.Add("id2", func(*AppContainer) (interface{}, error) {
+			return nil, fmt.Errorf("error 2")
+		})
+
+		expected := "application warm-up error : " +
+			"entry 'id1' factory error : error 1; " +
+			"entry 'id2' factory error : error 2"
+
+		if err := a.Boot(); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != expected {
+			t.Errorf("returned the (%v) error", err)
+		} else if a.boot {
+			t.Error("flagged the app as booted")
+		} else if a.container.Has("id1") {
+			t.Error("didn't closed the container")
+		}
+	})
+}
+
+func Test_App_Boot_Dependencies(t *testing.T) {
+	t.Run("error on missing dependency", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		p := appProviderDependent{NewMockAppProvider(ctrl), NewMockAppProviderDependent(ctrl)}
+		p.MockAppProviderDependent.EXPECT().Provides().Return([]string{"id1"}).AnyTimes()
+		p.MockAppProviderDependent.EXPECT().Requires().Return([]string{"id2"}).AnyTimes()
+		p.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1)
+		_ = a.Add(p)
+
+		if err := a.Boot(); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "provider 'servlet.appProviderDependent' requires the unavailable 'id2' dependency" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error on dependency cycle", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		p1 := NewMockAppProvider(ctrl)
+		p2 := appProviderDependent{NewMockAppProvider(ctrl), NewMockAppProviderDependent(ctrl)}
+		p2.MockAppProviderDependent.EXPECT().Provides().Return([]string{"id1"}).AnyTimes()
+		p2.MockAppProviderDependent.EXPECT().Requires().Return([]string{"id2"}).AnyTimes()
+		p3 := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		p4 := appProviderDependent{NewMockAppProvider(ctrl), NewMockAppProviderDependent(ctrl)}
+		p4.MockAppProviderDependent.EXPECT().Provides().Return([]string{"id2"}).AnyTimes()
+		p4.MockAppProviderDependent.EXPECT().Requires().Return([]string{"id1"}).AnyTimes()
+		_ = a.Add(p1)
+		_ = a.Add(p2)
+		_ = a.Add(p3)
+		_ = a.Add(p4)
+
+		expected := "provider dependency cycle : servlet.appProviderDependent -[id2]-> servlet.appProviderDependent -[id1]-> servlet.appProviderDependent"
+		if err := a.Boot(); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != expected {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("dependency satisfied by a container entry", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		_ = a.container.Add("id", func(*AppContainer) (interface{}, error) {
+			return "value", nil
+		})
+
+		p := appProviderDependent{NewMockAppProvider(ctrl), NewMockAppProviderDependent(ctrl)}
+		p.MockAppProviderDependent.EXPECT().Provides().Return([]string{}).AnyTimes()
+		p.MockAppProviderDependent.EXPECT().Requires().Return([]string{"id"}).AnyTimes()
+		p.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1)
+		p.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1)
+		_ = a.Add(p)
+
+		if err := a.Boot(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("dependency registered by a provider without declared dependencies", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		p1 := NewMockAppProvider(ctrl)
+		p1.EXPECT().Register(a.container).DoAndReturn(func(container *AppContainer) error {
+			return container.Add(ContainerFileSystemID, func(*AppContainer) (interface{}, error) {
+				return afero.NewMemMapFs(), nil
+			})
+		}).Times(1)
+		p1.EXPECT().Boot(a.container).Return(nil).Times(1)
+		params := NewConfigProviderParams()
+		params.EntrySourceActive = false
+		p2 := NewConfigProvider(params)
+		_ = a.Add(p1)
+		_ = a.Add(p2)
+
+		if err := a.Boot(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if fs, _ := a.container.Get(ContainerFileSystemID); fs == nil {
+			t.Error("didn't registered the file system")
+		} else if _, ok := fs.(*afero.MemMapFs); !ok {
+			t.Error("didn't used the provider registered file system")
+		}
+	})
+
+	t.Run("register and boot the providers in dependency order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		p1 := appProviderDependent{NewMockAppProvider(ctrl), NewMockAppProviderDependent(ctrl)}
+		p1.MockAppProviderDependent.EXPECT().Provides().Return([]string{"id1"}).AnyTimes()
+		p1.MockAppProviderDependent.EXPECT().Requires().Return([]string{"id2", "id3"}).AnyTimes()
+		p2 := NewMockAppProvider(ctrl)
+		p3 := appProviderDependent{NewMockAppProvider(ctrl), NewMockAppProviderDependent(ctrl)}
+		p3.MockAppProviderDependent.EXPECT().Provides().Return([]string{"id3"}).AnyTimes()
+		p3.MockAppProviderDependent.EXPECT().Requires().Return([]string{"id2"}).AnyTimes()
+		p4 := appProviderDependent{NewMockAppProvider(ctrl), NewMockAppProviderDependent(ctrl)}
+		p4.MockAppProviderDependent.EXPECT().Provides().Return([]string{"id2"}).AnyTimes()
+		p4.MockAppProviderDependent.EXPECT().Requires().Return([]string{}).AnyTimes()
+		gomock.InOrder(
+			p4.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1),
+			p3.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1),
+			p1.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1),
+			p2.EXPECT().Register(a.container).Return(nil).Times(1),
+			p4.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1),
+			p3.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1),
+			p1.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1),
+			p2.EXPECT().Boot(a.container).Return(nil).Times(1),
+		)
+		_ = a.Add(p1)
+		_ = a.Add(p2)
+		_ = a.Add(p3)
+		_ = a.Add(p4)
+
+		if err := a.Boot(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if a.providers[0] != p4 || a.providers[1] != p3 || a.providers[2] != p1 || a.providers[3] != p2 {
+			t.Error("didn't stored the providers in the boot order")
+		}
+	})
+
+	t.Run("boot the servlet providers", func(t *testing.T) {
+		a := NewApp()
+		params := NewConfigProviderParams()
+		params.EntrySourceActive = false
+		_ = a.Add(NewLogProvider(nil))
+		_ = a.Add(NewConfigProvider(params))
+		_ = a.Add(NewFileSystemProvider(nil))
+
+		if err := a.Boot(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		}
+		_ = a.Shutdown(context.Background())
+	})
+
+	t.Run("resolve the servlet providers objects by type", func(t *testing.T) {
+		type repository struct {
+			config *Config
+			logger *Log
+			fs     afero.Fs
+		}
+
+		a := NewApp()
+		params := NewConfigProviderParams()
+		params.EntrySourceActive = false
+		_ = a.Add(NewLogProvider(nil))
+		_ = a.Add(NewConfigProvider(params))
+		_ = a.Add(NewFileSystemProvider(nil))
+		_ = a.Boot()
+		defer func() { _ = a.Shutdown(context.Background()) }()
+
+		_ = a.Container().AddConstructor("repository", func(config *Config, logger *Log, fs afero.Fs) (*repository, error) {
+			return &repository{config, logger, fs}, nil
+		})
+
+		config, _ := a.Container().Get(ContainerConfigID)
+		logger, _ := a.Container().Get(ContainerLoggerID)
+		if entry, err := a.Container().Get("repository"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if repo := entry.(*repository); repo.config != config || repo.logger != logger || repo.fs == nil {
+			t.Errorf("returned the (%v) entry", repo)
+		}
+	})
+}
+
+func Test_App_Run(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var a *App
+		_ = a.Run(context.Background())
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		if err := NewApp().Run(nil); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'ctx' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error on boot", func(t *testing.T) {
+		expectedError := "error"
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		p := NewMockAppProvider(ctrl)
+		p.EXPECT().Register(a.container).Return(nil).Times(1)
+		p.EXPECT().Boot(a.container).Return(fmt.Errorf(expectedError)).Times(1)
+		_ = a.Add(p)
+
+		if err := a.Run(context.Background()); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "provider '*servlet.MockAppProvider' boot error : "+expectedError {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("shutdown the app on context cancellation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		p := NewMockAppProvider(ctrl)
+		p.EXPECT().Register(a.container).Return(nil).Times(1)
+		p.EXPECT().Boot(a.container).Return(nil).Times(1)
+		_ = a.Add(p)
+
+		entry := NewMockClosable(ctrl)
+		entry.EXPECT().Close().Times(1)
+		_ = a.container.Add("id", func(*AppContainer) (interface{}, error) {
+			return entry, nil
+		})
+		_, _ = a.container.Get("id")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+		}()
+
+		if err := a.Run(ctx); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if a.container.Has("id") {
+			t.Error("didn't closed the app container")
+		}
+	})
+
+	t.Run("shutdown the app on termination signal", func(t *testing.T) {
+		guard := make(chan os.Signal, 1)
+		signal.Notify(guard, syscall.SIGTERM)
+		defer signal.Stop(guard)
+
+		a := NewApp()
+
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			_ = syscall.Kill(os.Getpid(), syscall.SIGTERM)
+		}()
+
+		if err := a.Run(context.Background()); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error on shutdown timeout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		a.SetShutdownTimeout(10 * time.Millisecond)
+
+		entry := NewMockClosable(ctrl)
+		entry.EXPECT().Close().Do(func() { time.Sleep(50 * time.Millisecond) }).Times(1)
+		_ = a.container.Add("id", func(*AppContainer) (interface{}, error) {
+			return entry, nil
+		})
+		_, _ = a.container.Get("id")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if err := a.Run(ctx); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !strings.Contains(err.Error(), "application shutdown interrupted") {
+			t.Errorf("returned the (%v) error", err)
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	})
+}
+
+func Test_App_Shutdown(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var a *App
+		_ = a.Shutdown(context.Background())
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		if err := NewApp().Shutdown(nil); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'ctx' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("close the app container", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+
+		entry := NewMockClosable(ctrl)
+		entry.EXPECT().Close().Times(1)
+		_ = a.container.Add("id", func(*AppContainer) (interface{}, error) {
+			return entry, nil
+		})
+		_, _ = a.container.Get("id")
+
+		if err := a.Shutdown(context.Background()); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if a.container.Has("id") {
+			t.Error("didn't closed the app container")
+		}
+	})
+
+	t.Run("report the app container close error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+
+		expected := fmt.Errorf("error")
+		entry := NewMockLogStream(ctrl)
+		entry.EXPECT().Close().Return(expected).Times(1)
+		_ = a.container.Add("id", func(*AppContainer) (interface{}, error) {
+			return entry, nil
+		})
+		_, _ = a.container.Get("id")
+
+		if err := a.Shutdown(context.Background()); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, expected) {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+}
+
+func Test_App_Shutdown_Providers(t *testing.T) {
+	t.Run("don't shutdown providers of a non-booted app", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		p := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		_ = a.Add(p)
+
+		if err := a.Shutdown(context.Background()); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("shutdown providers in reverse order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		a := NewApp()
+		p1 := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		p1.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1)
+		p1.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1)
+		p2 := NewMockAppProvider(ctrl)
+		p2.EXPECT().Register(a.container).Return(nil).Times(1)
+		p2.EXPECT().Boot(a.container).Return(nil).Times(1)
+		p3 := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		p3.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1)
+		p3.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1)
+		gomock.InOrder(
+			p3.MockAppProviderShutdown.EXPECT().Shutdown(ctx, a.container).Return(nil).Times(1),
+			p1.MockAppProviderShutdown.EXPECT().Shutdown(ctx, a.container).Return(nil).Times(1),
+		)
+		_ = a.Add(p1)
+		_ = a.Add(p2)
+		_ = a.Add(p3)
+		_ = a.Boot()
+
+		if err := a.Shutdown(ctx); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if a.boot {
+			t.Error("didn't flagged the app as not booted")
+		}
+	})
+
+	t.Run("collect the providers shutdown errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		expectedError1 := fmt.Errorf("error 1")
+		expectedError2 := fmt.Errorf("error 2")
+
+		a := NewApp()
+		p1 := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		p1.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1)
+		p1.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1)
+		p1.MockAppProviderShutdown.EXPECT().Shutdown(ctx, a.container).Return(expectedError1).Times(1)
+		p2 := appProviderShutdown{NewMockAppProvider(ctrl), NewMockAppProviderShutdown(ctrl)}
+		p2.MockAppProvider.EXPECT().Register(a.container).Return(nil).Times(1)
+		p2.MockAppProvider.EXPECT().Boot(a.container).Return(nil).Times(1)
+		p2.MockAppProviderShutdown.EXPECT().Shutdown(ctx, a.container).Return(expectedError2).Times(1)
+		_ = a.Add(p1)
+		_ = a.Add(p2)
+		_ = a.Boot()
+
+		if err := a.Shutdown(ctx); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, expectedError1) || !errors.Is(err, expectedError2) {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+}
+
+func Test_App_LifecycleEvents(t *testing.T) {
+	t.Run("dispatch the boot and shutdown events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		p := NewMockAppProvider(ctrl)
+		p.EXPECT().Register(a.container).Return(nil).Times(1)
+		p.EXPECT().Boot(a.container).Return(nil).Times(1)
+		_ = a.Add(p)
+
+		events := []string{}
+		_, _ = a.Events().Subscribe(AppEventAll, func(e AppEvent) {
+			events = append(events, e.Name)
+			if e.Name == AppEventProviderRegistered && e.Data != p {
+				t.Errorf("dispatched the (%v) registered provider", e.Data)
+			}
+		})
+
+		_ = a.Boot()
+		_ = a.Shutdown(context.Background())
+
+		expected := []string{
+			AppEventProviderRegistered,
+			AppEventBooting,
+			AppEventBooted,
+			AppEventShutdownRequested,
+			AppEventClosed,
+		}
+		if !reflect.DeepEqual(events, expected) {
+			t.Errorf("dispatched the (%v) events", events)
+		}
+	})
+
+	t.Run("dispatch the closed event on boot rollback", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+		p := NewMockAppProvider(ctrl)
+		p.EXPECT().Register(a.container).Return(nil).Times(1)
+		p.EXPECT().Boot(a.container).Return(fmt.Errorf("error")).Times(1)
+		_ = a.Add(p)
+
+		events := []string{}
+		_, _ = a.Events().Subscribe(AppEventAll, func(e AppEvent) {
+			events = append(events, e.Name)
+		})
+
+		_ = a.Boot()
+
+		expected := []string{
+			AppEventProviderRegistered,
+			AppEventBooting,
+			AppEventClosed,
+		}
+		if !reflect.DeepEqual(events, expected) {
+			t.Errorf("dispatched the (%v) events", events)
+		}
+	})
+}
+
+func Test_App_AddRunner(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var a *App
+		_ = a.AddRunner("id", nil, AppRunnerPolicy{})
+	})
+
+	t.Run("start the runners on boot and stop them on shutdown", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		a := NewApp()
+
+		started := make(chan bool, 1)
+		runner := NewMockAppRunner(ctrl)
+		runner.EXPECT().Run(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+			started <- true
+			<-ctx.Done()
+			return nil
+		}).Times(1)
+
+		if err := a.AddRunner("id", runner, AppRunnerPolicy{}); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !a.supervisor.Has("id") {
+			t.Error("didn't registered the runner")
+		}
+
+		_ = a.Boot()
+		select {
+		case <-started:
+		case <-time.After(100 * time.Millisecond):
+			t.Error("didn't started the runner")
+		}
+
+		if err := a.Shutdown(context.Background()); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if a.supervisor.IsRunning() {
+			t.Error("didn't stopped the runners")
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package servlet
+
+import "time"
+
+// backoff calculates the exponential backoff delay for the given number of
+// consecutive failures, where the base delay is doubled on every failure
+// after the first one until reaching the limit (if defined).
+func backoff(base, limit time.Duration, failures int) time.Duration {
+	delay := base
+	for i := 1; i < failures; i++ {
+		delay *= 2
+		if limit > 0 && delay >= limit {
+			break
+		}
+	}
+
+	if limit > 0 && delay > limit {
+		return limit
+	}
+	return delay
+}
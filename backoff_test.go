@@ -0,0 +1,49 @@
+package servlet
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_backoff(t *testing.T) {
+	scenarios := []struct {
+		base     time.Duration
+		limit    time.Duration
+		failures int
+		expected time.Duration
+	}{
+		{ // test without failures
+			base:     time.Second,
+			failures: 0,
+			expected: time.Second,
+		},
+		{ // test first failure
+			base:     time.Second,
+			failures: 1,
+			expected: time.Second,
+		},
+		{ // test consecutive failures
+			base:     time.Second,
+			failures: 4,
+			expected: 8 * time.Second,
+		},
+		{ // test limited delay
+			base:     time.Second,
+			limit:    5 * time.Second,
+			failures: 100,
+			expected: 5 * time.Second,
+		},
+		{ // test base delay above the limit
+			base:     10 * time.Second,
+			limit:    5 * time.Second,
+			failures: 1,
+			expected: 5 * time.Second,
+		},
+	}
+
+	for _, scn := range scenarios {
+		if delay := backoff(scn.base, scn.limit, scn.failures); delay != scn.expected {
+			t.Errorf("returned the (%v) delay for (%d) failures", delay, scn.failures)
+		}
+	}
+}
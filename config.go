@@ -1,6 +1,7 @@
 package servlet
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sort"
@@ -49,19 +50,16 @@ type Config struct {
 // registration of configuration path/values observer callbacks that will be
 // called whenever the value has changed.
 func NewConfig(period time.Duration) (*Config, error) {
-	var c *Config
-
-	var loader *TriggerRecurring
-	if period != 0 {
-		loader, _ = NewTriggerRecurring(period, func() error { return c.reload() })
-	}
-
-	c = &Config{
+	c := &Config{
 		mutex:     &sync.Mutex{},
 		sources:   []configRefSource{},
 		observers: []configRefObserver{},
 		partial:   ConfigPartial{},
-		loader:    loader,
+	}
+
+	if period != 0 {
+		policy := TriggerFailurePolicy{Action: TriggerFailureContinue}
+		c.loader, _ = NewTriggerRecurringWithPolicies(period, TriggerSchedulePolicy{}, policy, func(context.Context) error { return c.reload() })
 	}
 
 	return c, nil
@@ -74,10 +72,7 @@ func (c *Config) Close() {
 		panic(fmt.Errorf("nil pointer receiver"))
 	}
 
-	if c.loader != nil {
-		c.loader.Stop()
-		c.loader = nil
-	}
+	c.stopLoader()
 
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
@@ -764,12 +759,28 @@ func (c *Config) RemoveObserver(path string) {
 	}
 }
 
+func (c *Config) stopLoader() {
+	if c.loader != nil {
+		c.loader.Stop()
+		c.loader.Wait()
+		c.loader = nil
+	}
+}
+
 func (c *Config) reload() error {
+	c.mutex.Lock()
+	sources := append([]configRefSource{}, c.sources...)
+	c.mutex.Unlock()
+
+	errs := AppErrors{}
 	rebuild := false
-	for _, ref := range c.sources {
+	for _, ref := range sources {
 		switch s := ref.source.(type) {
 		case ConfigSourceObservable:
-			changed, _ := s.Reload()
+			changed, err := s.Reload()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("source '%s' reload error : %w", ref.id, err))
+			}
 			rebuild = rebuild || changed
 		}
 	}
@@ -781,7 +792,7 @@ func (c *Config) reload() error {
 		c.rebuild()
 	}
 
-	return nil
+	return errs.errorOrNil()
 }
 
 func (c *Config) rebuild() {
@@ -50,6 +50,17 @@ const (
 	// container config decoder factory id.
 	EnvContainerConfigDecoderFactoryID = "SERVLET_CONTAINER_CONFIG_DECODER_FACTORY_ID"
 
+	// ConfigDecoderFactoryStrategyTag defines the tag to be used as the
+	// default to mark the config decoder factory strategies in the
+	// application container, so they can be registered in the decoder
+	// factory on the config provider boot.
+	ConfigDecoderFactoryStrategyTag = "servlet.config.decoder.strategy"
+
+	// EnvConfigDecoderFactoryStrategyTag defines the name of the environment
+	// variable to be checked for a overriding value for the config decoder
+	// factory strategy tag.
+	EnvConfigDecoderFactoryStrategyTag = "SERVLET_CONFIG_DECODER_FACTORY_STRATEGY_TAG"
+
 	// ContainerConfigSourceFactoryStrategyFileID defines the id to be used as
 	// the default of a config file source factory strategy instance in the
 	// application container.
@@ -89,6 +100,17 @@ const (
 	// container config source factory id.
 	EnvContainerConfigSourceFactoryID = "SERVLET_CONTAINER_CONFIG_SOURCE_FACTORY_ID"
 
+	// ConfigSourceFactoryStrategyTag defines the tag to be used as the
+	// default to mark the config source factory strategies in the
+	// application container, so they can be registered in the source
+	// factory on the config provider boot.
+	ConfigSourceFactoryStrategyTag = "servlet.config.source.strategy"
+
+	// EnvConfigSourceFactoryStrategyTag defines the name of the environment
+	// variable to be checked for a overriding value for the config source
+	// factory strategy tag.
+	EnvConfigSourceFactoryStrategyTag = "SERVLET_CONFIG_SOURCE_FACTORY_STRATEGY_TAG"
+
 	// ContainerConfigLoaderID defines the id to be used as the default of a
 	// config loader instance in the application container.
 	ContainerConfigLoaderID = "servlet.config.loader"
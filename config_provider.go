@@ -1,8 +1,10 @@
 package servlet
 
 import (
+	"context"
 	"fmt"
 	"github.com/spf13/afero"
+	"reflect"
 )
 
 // ConfigProvider defines the default configuration provider to be used on
@@ -23,8 +25,32 @@ func NewConfigProvider(params *ConfigProviderParams) *ConfigProvider {
 	}
 }
 
+// Provides will retrieve the list of container entries registered
+// by the provider.
+func (p ConfigProvider) Provides() []string {
+	return []string{
+		p.params.DecoderFactoryStrategyYamlID,
+		p.params.DecoderFactoryID,
+		p.params.SourceFactoryStrategyFileID,
+		p.params.SourceFactoryStrategyObservableFileID,
+		p.params.SourceFactoryStrategyEnvironmentID,
+		p.params.SourceFactoryID,
+		p.params.ConfigID,
+		p.params.LoaderID,
+	}
+}
+
+// Requires will retrieve the list of container entries needed by the
+// provider, that should be registered by other providers.
+func (p ConfigProvider) Requires() []string {
+	return []string{
+		p.params.FileSystemID,
+	}
+}
+
 // Register will register the configuration section instances in the
-// application container.
+// application container, binding their types so they can be resolved by
+// the constructors registered with the container AddConstructor method.
 func (p ConfigProvider) Register(container *AppContainer) error {
 	if container == nil {
 		return fmt.Errorf("invalid nil 'container' argument")
@@ -32,19 +58,13 @@ func (p ConfigProvider) Register(container *AppContainer) error {
 
 	_ = container.Add(p.params.DecoderFactoryStrategyYamlID, func(container *AppContainer) (interface{}, error) {
 		return NewConfigDecoderFactoryStrategyYaml(), nil
-	})
+	}, p.params.DecoderFactoryStrategyTag)
 
 	_ = container.Add(p.params.DecoderFactoryID, func(container *AppContainer) (interface{}, error) {
 		return NewConfigDecoderFactory(), nil
 	})
 
-	_ = container.Add(p.params.SourceFactoryStrategyFileID, func(container *AppContainer) (strategy interface{}, err error) {
-		defer func() {
-			if r := recover(); r != nil {
-				err = r.(error)
-			}
-		}()
-
+	_ = container.Add(p.params.SourceFactoryStrategyFileID, func(container *AppContainer) (interface{}, error) {
 		fileSystem, err := container.Get(p.params.FileSystemID)
 		if err != nil {
 			return nil, err
@@ -56,15 +76,9 @@ func (p ConfigProvider) Register(container *AppContainer) error {
 		}
 
 		return NewConfigSourceFactoryStrategyFile(fileSystem.(afero.Fs), decoderFactory.(*ConfigDecoderFactory))
-	})
-
-	_ = container.Add(p.params.SourceFactoryStrategyObservableFileID, func(container *AppContainer) (strategy interface{}, err error) {
-		defer func() {
-			if r := recover(); r != nil {
-				err = r.(error)
-			}
-		}()
+	}, p.params.SourceFactoryStrategyTag)
 
+	_ = container.Add(p.params.SourceFactoryStrategyObservableFileID, func(container *AppContainer) (interface{}, error) {
 		fileSystem, err := container.Get(p.params.FileSystemID)
 		if err != nil {
 			return nil, err
@@ -76,11 +90,11 @@ func (p ConfigProvider) Register(container *AppContainer) error {
 		}
 
 		return NewConfigSourceFactoryStrategyObservableFile(fileSystem.(afero.Fs), decoderFactory.(*ConfigDecoderFactory))
-	})
+	}, p.params.SourceFactoryStrategyTag)
 
 	_ = container.Add(p.params.SourceFactoryStrategyEnvironmentID, func(container *AppContainer) (interface{}, error) {
 		return NewConfigSourceFactoryStrategyEnvironment()
-	})
+	}, p.params.SourceFactoryStrategyTag)
 
 	_ = container.Add(p.params.SourceFactoryID, func(container *AppContainer) (obj interface{}, err error) {
 		return NewConfigSourceFactory(), nil
@@ -90,13 +104,7 @@ func (p ConfigProvider) Register(container *AppContainer) error {
 		return NewConfig(p.params.ObserveFrequency)
 	})
 
-	_ = container.Add(p.params.LoaderID, func(container *AppContainer) (obj interface{}, err error) {
-		defer func() {
-			if r := recover(); r != nil {
-				err = r.(error)
-			}
-		}()
-
+	_ = container.Add(p.params.LoaderID, func(container *AppContainer) (interface{}, error) {
 		config, err := container.Get(p.params.ConfigID)
 		if err != nil {
 			return nil, err
@@ -110,15 +118,26 @@ func (p ConfigProvider) Register(container *AppContainer) error {
 		return NewConfigLoader(config.(*Config), sourceFactory.(*ConfigSourceFactory))
 	})
 
+	for id, typ := range map[string]reflect.Type{
+		p.params.DecoderFactoryID: reflect.TypeOf(&ConfigDecoderFactory{}),
+		p.params.SourceFactoryID:  reflect.TypeOf(&ConfigSourceFactory{}),
+		p.params.ConfigID:         reflect.TypeOf(&Config{}),
+		p.params.LoaderID:         reflect.TypeOf(&ConfigLoader{}),
+	} {
+		_ = container.Bind(typ, id)
+	}
+
 	return nil
 }
 
-// Boot will start the configuration config instance by calling the
-// configuration loader with the defined provider base entry information.
+// Boot will register all the decoder and source factory strategies tagged in
+// the application container into the respective factories, and start the
+// configuration config instance by calling the configuration loader with the
+// defined provider base entry information.
 func (p ConfigProvider) Boot(container *AppContainer) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			err = r.(error)
+			err = panicError(r)
 		}
 	}()
 
@@ -128,12 +147,12 @@ func (p ConfigProvider) Boot(container *AppContainer) (err error) {
 			return err
 		}
 
-		{
-			strategy, err := container.Get(p.params.DecoderFactoryStrategyYamlID)
-			if err != nil {
-				return err
-			}
+		strategies, err := container.GetTagged(p.params.DecoderFactoryStrategyTag)
+		if err != nil {
+			return err
+		}
 
+		for _, strategy := range strategies {
 			_ = factory.(*ConfigDecoderFactory).Register(strategy.(ConfigDecoderFactoryStrategy))
 		}
 	}
@@ -144,30 +163,12 @@ func (p ConfigProvider) Boot(container *AppContainer) (err error) {
 			return err
 		}
 
-		{
-			strategy, err := container.Get(p.params.SourceFactoryStrategyFileID)
-			if err != nil {
-				return err
-			}
-
-			_ = factory.(*ConfigSourceFactory).Register(strategy.(ConfigSourceFactoryStrategy))
-		}
-
-		{
-			strategy, err := container.Get(p.params.SourceFactoryStrategyObservableFileID)
-			if err != nil {
-				return err
-			}
-
-			_ = factory.(*ConfigSourceFactory).Register(strategy.(ConfigSourceFactoryStrategy))
+		strategies, err := container.GetTagged(p.params.SourceFactoryStrategyTag)
+		if err != nil {
+			return err
 		}
 
-		{
-			strategy, err := container.Get(p.params.SourceFactoryStrategyEnvironmentID)
-			if err != nil {
-				return err
-			}
-
+		for _, strategy := range strategies {
 			_ = factory.(*ConfigSourceFactory).Register(strategy.(ConfigSourceFactoryStrategy))
 		}
 	}
@@ -183,3 +184,26 @@ func (p ConfigProvider) Boot(container *AppContainer) (err error) {
 
 	return nil
 }
+
+// Shutdown will stop the configuration observable sources reload trigger,
+// so no configuration change will be propagated while the application is
+// terminating. Nothing is done if the configuration was never instantiated.
+func (p ConfigProvider) Shutdown(_ context.Context, container *AppContainer) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicError(r)
+		}
+	}()
+
+	if !container.Instantiated(p.params.ConfigID) {
+		return nil
+	}
+
+	config, err := container.Get(p.params.ConfigID)
+	if err != nil {
+		return err
+	}
+
+	config.(*Config).stopLoader()
+	return nil
+}
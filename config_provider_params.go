@@ -15,8 +15,10 @@ type ConfigProviderParams struct {
 	SourceFactoryStrategyObservableFileID string
 	SourceFactoryStrategyEnvironmentID    string
 	SourceFactoryID                       string
+	SourceFactoryStrategyTag              string
 	DecoderFactoryStrategyYamlID          string
 	DecoderFactoryID                      string
+	DecoderFactoryStrategyTag             string
 	LoaderID                              string
 	ObserveFrequency                      time.Duration
 	EntrySourceActive                     bool
@@ -35,8 +37,10 @@ func NewConfigProviderParams() *ConfigProviderParams {
 		SourceFactoryStrategyObservableFileID: ContainerConfigSourceFactoryStrategyObservableFileID,
 		SourceFactoryStrategyEnvironmentID:    ContainerConfigSourceFactoryStrategyEnvironmentID,
 		SourceFactoryID:                       ContainerConfigSourceFactoryID,
+		SourceFactoryStrategyTag:              ConfigSourceFactoryStrategyTag,
 		DecoderFactoryStrategyYamlID:          ContainerConfigDecoderFactoryStrategyYamlID,
 		DecoderFactoryID:                      ContainerConfigDecoderFactoryID,
+		DecoderFactoryStrategyTag:             ConfigDecoderFactoryStrategyTag,
 		LoaderID:                              ContainerConfigLoaderID,
 		ObserveFrequency:                      ConfigObserveFrequency,
 		EntrySourceActive:                     ConfigEntrySourceActive,
@@ -69,6 +73,10 @@ func NewConfigProviderParams() *ConfigProviderParams {
 		params.SourceFactoryID = env
 	}
 
+	if env := os.Getenv(EnvConfigSourceFactoryStrategyTag); env != "" {
+		params.SourceFactoryStrategyTag = env
+	}
+
 	if env := os.Getenv(EnvContainerConfigDecoderFactoryStrategyYamlID); env != "" {
 		params.DecoderFactoryStrategyYamlID = env
 	}
@@ -77,6 +85,10 @@ func NewConfigProviderParams() *ConfigProviderParams {
 		params.DecoderFactoryID = env
 	}
 
+	if env := os.Getenv(EnvConfigDecoderFactoryStrategyTag); env != "" {
+		params.DecoderFactoryStrategyTag = env
+	}
+
 	if env := os.Getenv(EnvContainerConfigLoaderID); env != "" {
 		params.LoaderID = env
 	}
@@ -24,10 +24,14 @@ func Test_NewConfigProviderParams(t *testing.T) {
 			t.Errorf("stored (%v) source factory strategy environment ID", value)
 		} else if value := parameters.SourceFactoryID; value != ContainerConfigSourceFactoryID {
 			t.Errorf("stored (%v) source factory ID", value)
+		} else if value := parameters.SourceFactoryStrategyTag; value != ConfigSourceFactoryStrategyTag {
+			t.Errorf("stored (%v) source factory strategy tag", value)
 		} else if value := parameters.DecoderFactoryStrategyYamlID; value != ContainerConfigDecoderFactoryStrategyYamlID {
 			t.Errorf("stored (%v) decoder factory strategy yaml ID", value)
 		} else if value := parameters.DecoderFactoryID; value != ContainerConfigDecoderFactoryID {
 			t.Errorf("stored (%v) decoder factory ID", value)
+		} else if value := parameters.DecoderFactoryStrategyTag; value != ConfigDecoderFactoryStrategyTag {
+			t.Errorf("stored (%v) decoder factory strategy tag", value)
 		} else if value := parameters.LoaderID; value != ContainerConfigLoaderID {
 			t.Errorf("stored (%v) loader ID", value)
 		} else if value := parameters.ObserveFrequency; value != ConfigObserveFrequency {
@@ -109,6 +113,17 @@ func Test_NewConfigProviderParams(t *testing.T) {
 		}
 	})
 
+	t.Run("with the env source factory strategy tag", func(t *testing.T) {
+		value := "source_strategy_tag"
+		_ = os.Setenv(EnvConfigSourceFactoryStrategyTag, value)
+		defer func() { _ = os.Setenv(EnvConfigSourceFactoryStrategyTag, "") }()
+
+		parameters := NewConfigProviderParams()
+		if check := parameters.SourceFactoryStrategyTag; check != value {
+			t.Errorf("stored (%v) source factory strategy tag", check)
+		}
+	})
+
 	t.Run("with the env decoder factory strategy yaml ID", func(t *testing.T) {
 		value := "decoder_factory_id"
 		_ = os.Setenv(EnvContainerConfigDecoderFactoryStrategyYamlID, value)
@@ -131,6 +146,17 @@ func Test_NewConfigProviderParams(t *testing.T) {
 		}
 	})
 
+	t.Run("with the env decoder factory strategy tag", func(t *testing.T) {
+		value := "decoder_strategy_tag"
+		_ = os.Setenv(EnvConfigDecoderFactoryStrategyTag, value)
+		defer func() { _ = os.Setenv(EnvConfigDecoderFactoryStrategyTag, "") }()
+
+		parameters := NewConfigProviderParams()
+		if check := parameters.DecoderFactoryStrategyTag; check != value {
+			t.Errorf("stored (%v) decoder factory strategy tag", check)
+		}
+	})
+
 	t.Run("with the env loader ID", func(t *testing.T) {
 		value := "loader_id"
 		_ = os.Setenv(EnvContainerConfigLoaderID, value)
@@ -1,6 +1,8 @@
 package servlet
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/golang/mock/gomock"
 	"io"
@@ -8,6 +10,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 func Test_NewConfigProvider(t *testing.T) {
@@ -29,6 +32,35 @@ func Test_NewConfigProvider(t *testing.T) {
 	})
 }
 
+func Test_ConfigProvider_Provides(t *testing.T) {
+	t.Run("retrieve the provided entries", func(t *testing.T) {
+		expected := []string{
+			ContainerConfigDecoderFactoryStrategyYamlID,
+			ContainerConfigDecoderFactoryID,
+			ContainerConfigSourceFactoryStrategyFileID,
+			ContainerConfigSourceFactoryStrategyObservableFileID,
+			ContainerConfigSourceFactoryStrategyEnvironmentID,
+			ContainerConfigSourceFactoryID,
+			ContainerConfigID,
+			ContainerConfigLoaderID,
+		}
+
+		if provides := NewConfigProvider(nil).Provides(); !reflect.DeepEqual(provides, expected) {
+			t.Errorf("returned the (%v) list", provides)
+		}
+	})
+}
+
+func Test_ConfigProvider_Requires(t *testing.T) {
+	t.Run("retrieve the required entries", func(t *testing.T) {
+		expected := []string{ContainerFileSystemID}
+
+		if requires := NewConfigProvider(nil).Requires(); !reflect.DeepEqual(requires, expected) {
+			t.Errorf("returned the (%v) list", requires)
+		}
+	})
+}
+
 func Test_ConfigProvider_Register(t *testing.T) {
 	t.Run("nil container", func(t *testing.T) {
 		provider := NewConfigProvider(nil)
@@ -111,7 +143,7 @@ func Test_ConfigProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -129,7 +161,7 @@ func Test_ConfigProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if strings.Index(err.Error(), "interface conversion") != 0 {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.Contains(err.Error(), "interface conversion") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -147,7 +179,7 @@ func Test_ConfigProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -165,7 +197,7 @@ func Test_ConfigProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if strings.Index(err.Error(), "interface conversion") != 0 {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.Contains(err.Error(), "interface conversion") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -200,7 +232,7 @@ func Test_ConfigProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -218,7 +250,7 @@ func Test_ConfigProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if strings.Index(err.Error(), "interface conversion") != 0 {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.Contains(err.Error(), "interface conversion") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -236,7 +268,7 @@ func Test_ConfigProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -254,7 +286,7 @@ func Test_ConfigProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if strings.Index(err.Error(), "interface conversion") != 0 {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.Contains(err.Error(), "interface conversion") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -342,7 +374,7 @@ func Test_ConfigProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -360,7 +392,7 @@ func Test_ConfigProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if strings.Index(err.Error(), "interface conversion") != 0 {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.Contains(err.Error(), "interface conversion") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -378,7 +410,7 @@ func Test_ConfigProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -396,7 +428,7 @@ func Test_ConfigProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if strings.Index(err.Error(), "interface conversion") != 0 {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.Contains(err.Error(), "interface conversion") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -434,7 +466,7 @@ func Test_ConfigProvider_Boot(t *testing.T) {
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error")
-		} else if err != expected {
+		} else if !errors.Is(err, expected) {
 			t.Errorf("returned the unexpected (%v) error", err)
 		}
 	})
@@ -464,11 +496,11 @@ func Test_ConfigProvider_Boot(t *testing.T) {
 
 		_ = container.Add(ContainerConfigDecoderFactoryStrategyYamlID, func(container *AppContainer) (interface{}, error) {
 			return nil, expected
-		})
+		}, ConfigDecoderFactoryStrategyTag)
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error")
-		} else if err != expected {
+		} else if !errors.Is(err, expected) {
 			t.Errorf("returned the unexpected (%v) error", err)
 		}
 	})
@@ -480,7 +512,7 @@ func Test_ConfigProvider_Boot(t *testing.T) {
 
 		_ = container.Add(ContainerConfigDecoderFactoryStrategyYamlID, func(container *AppContainer) (interface{}, error) {
 			return "string", nil
-		})
+		}, ConfigDecoderFactoryStrategyTag)
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error")
@@ -502,7 +534,7 @@ func Test_ConfigProvider_Boot(t *testing.T) {
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error")
-		} else if err != expected {
+		} else if !errors.Is(err, expected) {
 			t.Errorf("returned the unexpected (%v) error", err)
 		}
 	})
@@ -534,11 +566,11 @@ func Test_ConfigProvider_Boot(t *testing.T) {
 
 		_ = container.Add(ContainerConfigSourceFactoryStrategyFileID, func(container *AppContainer) (interface{}, error) {
 			return nil, expected
-		})
+		}, ConfigSourceFactoryStrategyTag)
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error")
-		} else if err != expected {
+		} else if !errors.Is(err, expected) {
 			t.Errorf("returned the unexpected (%v) error", err)
 		}
 	})
@@ -551,7 +583,7 @@ func Test_ConfigProvider_Boot(t *testing.T) {
 
 		_ = container.Add(ContainerConfigSourceFactoryStrategyFileID, func(container *AppContainer) (interface{}, error) {
 			return "string", nil
-		})
+		}, ConfigSourceFactoryStrategyTag)
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error")
@@ -570,11 +602,11 @@ func Test_ConfigProvider_Boot(t *testing.T) {
 
 		_ = container.Add(ContainerConfigSourceFactoryStrategyObservableFileID, func(container *AppContainer) (interface{}, error) {
 			return nil, expected
-		})
+		}, ConfigSourceFactoryStrategyTag)
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error")
-		} else if err != expected {
+		} else if !errors.Is(err, expected) {
 			t.Errorf("returned the unexpected (%v) error", err)
 		}
 	})
@@ -587,7 +619,7 @@ func Test_ConfigProvider_Boot(t *testing.T) {
 
 		_ = container.Add(ContainerConfigSourceFactoryStrategyObservableFileID, func(container *AppContainer) (interface{}, error) {
 			return "string", nil
-		})
+		}, ConfigSourceFactoryStrategyTag)
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error")
@@ -606,11 +638,11 @@ func Test_ConfigProvider_Boot(t *testing.T) {
 
 		_ = container.Add(ContainerConfigSourceFactoryStrategyEnvironmentID, func(container *AppContainer) (interface{}, error) {
 			return nil, expected
-		})
+		}, ConfigSourceFactoryStrategyTag)
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error")
-		} else if err != expected {
+		} else if !errors.Is(err, expected) {
 			t.Errorf("returned the unexpected (%v) error", err)
 		}
 	})
@@ -623,7 +655,7 @@ func Test_ConfigProvider_Boot(t *testing.T) {
 
 		_ = container.Add(ContainerConfigSourceFactoryStrategyEnvironmentID, func(container *AppContainer) (interface{}, error) {
 			return "string", nil
-		})
+		}, ConfigSourceFactoryStrategyTag)
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error")
@@ -632,6 +664,42 @@ func Test_ConfigProvider_Boot(t *testing.T) {
 		}
 	})
 
+	t.Run("register the tagged third-party strategies", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		decoderStrategy := NewMockConfigDecoderFactoryStrategy(ctrl)
+		sourceStrategy := NewMockConfigSourceFactoryStrategy(ctrl)
+
+		container := NewAppContainer()
+		_ = NewFileSystemProvider(nil).Register(container)
+
+		params := NewConfigProviderParams()
+		params.EntrySourceActive = false
+		provider := NewConfigProvider(params)
+		_ = provider.Register(container)
+
+		_ = container.Add("decoder.strategy", func(*AppContainer) (interface{}, error) {
+			return decoderStrategy, nil
+		}, ConfigDecoderFactoryStrategyTag)
+		_ = container.Add("source.strategy", func(*AppContainer) (interface{}, error) {
+			return sourceStrategy, nil
+		}, ConfigSourceFactoryStrategyTag)
+
+		if err := provider.Boot(container); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		}
+
+		decoderFactory, _ := container.Get(ContainerConfigDecoderFactoryID)
+		sourceFactory, _ := container.Get(ContainerConfigSourceFactoryID)
+
+		if strategies := decoderFactory.(*ConfigDecoderFactory).strategies; len(strategies) != 2 || strategies[0] != decoderStrategy {
+			t.Error("didn't registered the tagged decoder factory strategy")
+		} else if strategies := sourceFactory.(*ConfigSourceFactory).strategies; len(strategies) != 4 || strategies[0] != sourceStrategy {
+			t.Error("didn't registered the tagged source factory strategy")
+		}
+	})
+
 	t.Run("no entry source active", func(t *testing.T) {
 		container := NewAppContainer()
 		_ = NewFileSystemProvider(nil).Register(container)
@@ -662,7 +730,7 @@ func Test_ConfigProvider_Boot(t *testing.T) {
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -712,3 +780,62 @@ func Test_ConfigProvider_Boot(t *testing.T) {
 		}
 	})
 }
+
+func Test_ConfigProvider_Shutdown(t *testing.T) {
+	t.Run("skip a not instantiated config", func(t *testing.T) {
+		container := NewAppContainer()
+		provider := NewConfigProvider(nil)
+		_ = provider.Register(container)
+
+		called := false
+		_ = container.Add(ContainerConfigID, func(*AppContainer) (interface{}, error) {
+			called = true
+			return nil, fmt.Errorf("error")
+		})
+
+		if err := provider.Shutdown(context.Background(), container); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if called {
+			t.Error("instantiated the config")
+		}
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		container := NewAppContainer()
+		provider := NewConfigProvider(nil)
+		_ = provider.Register(container)
+
+		_ = container.Add(ContainerConfigID, func(*AppContainer) (interface{}, error) {
+			return "string", nil
+		})
+		_, _ = container.Get(ContainerConfigID)
+
+		if err := provider.Shutdown(context.Background(), container); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if strings.Index(err.Error(), "interface conversion") != 0 {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("stop the config reload trigger", func(t *testing.T) {
+		params := NewConfigProviderParams()
+		params.ObserveFrequency = 20 * time.Millisecond
+
+		container := NewAppContainer()
+		defer container.Close()
+
+		provider := NewConfigProvider(params)
+		_ = provider.Register(container)
+
+		config, _ := container.Get(ContainerConfigID)
+		loader := config.(*Config).loader
+
+		if err := provider.Shutdown(context.Background(), container); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !loader.IsStopped() {
+			t.Error("didn't stopped the config reload trigger")
+		} else if config.(*Config).loader != nil {
+			t.Error("didn't removed the config reload trigger")
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package servlet
 
 import (
+	"fmt"
 	"github.com/golang/mock/gomock"
 	"reflect"
 	"testing"
@@ -42,6 +43,8 @@ func Test_NewConfig(t *testing.T) {
 				t.Error("didn't instantiate the observers storing array")
 			} else if config.loader == nil {
 				t.Error("didn't instantiate the sources reload trigger")
+			} else if config.loader.failure.Action != TriggerFailureContinue {
+				t.Error("didn't instantiate the reload trigger with the continue failure policy")
 			}
 		}
 	})
@@ -4087,6 +4090,26 @@ func Test_Config(t *testing.T) {
 		time.Sleep(60 * time.Millisecond)
 	})
 
+	t.Run("keep reloading after a source reload error", func(t *testing.T) {
+		id := "source"
+		priority := 0
+		partial := ConfigPartial{"node": "value"}
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config, _ := NewConfig(20 * time.Millisecond)
+		defer config.Close()
+
+		source := NewMockConfigObservableSource(ctrl)
+		source.EXPECT().Close().Times(1)
+		source.EXPECT().Get("").Return(partial).Times(1)
+		source.EXPECT().Reload().Return(false, fmt.Errorf("__dummy_error__")).MinTimes(2)
+		_ = config.AddSource(id, priority, source)
+
+		time.Sleep(70 * time.Millisecond)
+	})
+
 	t.Run("rebuild if the observable source notify changes", func(t *testing.T) {
 		id := "source"
 		priority := 0
@@ -2,6 +2,7 @@ package servlet
 
 import (
 	"github.com/spf13/afero"
+	"reflect"
 )
 
 // FileSystemProvider defines the default configuration provider to be used on
@@ -22,11 +23,28 @@ func NewFileSystemProvider(params *FileSystemProviderParams) *FileSystemProvider
 	}
 }
 
-// Register will add to the container a new file system adapter instance.
+// Provides will retrieve the list of container entries registered
+// by the provider.
+func (p FileSystemProvider) Provides() []string {
+	return []string{p.params.FileSystemID}
+}
+
+// Requires will retrieve the list of container entries needed by the
+// provider (none).
+func (FileSystemProvider) Requires() []string {
+	return []string{}
+}
+
+// Register will add to the container a new file system adapter instance,
+// bound to the afero.Fs type.
 func (p FileSystemProvider) Register(c *AppContainer) error {
-	return c.Add(p.params.FileSystemID, func(c *AppContainer) (interface{}, error) {
+	if err := c.Add(p.params.FileSystemID, func(c *AppContainer) (interface{}, error) {
 		return afero.NewOsFs(), nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	return c.Bind(reflect.TypeOf((*afero.Fs)(nil)).Elem(), p.params.FileSystemID)
 }
 
 // Boot (no-op).
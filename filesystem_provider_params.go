@@ -1,6 +1,9 @@
 package servlet
 
-import "os"
+import (
+	"fmt"
+	"os"
+)
 
 // FileSystemProviderParams defines the system provider parameters storing structure
 // that will be needed when instantiating a new provider
@@ -21,3 +24,26 @@ func NewFileSystemProviderParams() *FileSystemProviderParams {
 
 	return params
 }
+
+// NewFileSystemProviderParamsConfig instantiate a new file system provider
+// parameters object with the default values, overridden by the values
+// defined in the given configuration partial.
+func NewFileSystemProviderParamsConfig(conf ConfigPartial) (params *FileSystemProviderParams, err error) {
+	if conf == nil {
+		return nil, fmt.Errorf("invalid nil 'conf' argument")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			params, err = nil, panicError(r)
+		}
+	}()
+
+	params = NewFileSystemProviderParams()
+
+	if conf.Has("file_system_id") {
+		params.FileSystemID = conf.String("file_system_id")
+	}
+
+	return params, nil
+}
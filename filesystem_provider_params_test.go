@@ -2,6 +2,8 @@ package servlet
 
 import (
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -24,3 +26,41 @@ func Test_NewFileSystemParams(t *testing.T) {
 		}
 	})
 }
+
+func Test_NewFileSystemProviderParamsConfig(t *testing.T) {
+	t.Run("nil config", func(t *testing.T) {
+		if params, err := NewFileSystemProviderParamsConfig(nil); params != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'conf' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("invalid config value", func(t *testing.T) {
+		if params, err := NewFileSystemProviderParamsConfig(ConfigPartial{"file_system_id": 123}); params != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !strings.HasPrefix(err.Error(), "interface conversion") {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("with the default values", func(t *testing.T) {
+		if params, err := NewFileSystemProviderParamsConfig(ConfigPartial{}); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !reflect.DeepEqual(params, NewFileSystemProviderParams()) {
+			t.Errorf("returned the (%v) params", params)
+		}
+	})
+
+	t.Run("with the config file system ID", func(t *testing.T) {
+		if params, err := NewFileSystemProviderParamsConfig(ConfigPartial{"file_system_id": "file_system_id"}); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if check := params.FileSystemID; check != "file_system_id" {
+			t.Errorf("stored (%v) file system ID", check)
+		}
+	})
+}
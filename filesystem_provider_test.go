@@ -25,6 +25,25 @@ func Test_NewFileSystemProvider(t *testing.T) {
 	})
 }
 
+func Test_FileSystemProvider_Provides(t *testing.T) {
+	t.Run("retrieve the provided entries", func(t *testing.T) {
+		params := NewFileSystemProviderParams()
+		params.FileSystemID = "id"
+
+		if provides := NewFileSystemProvider(params).Provides(); !reflect.DeepEqual(provides, []string{"id"}) {
+			t.Errorf("returned the (%v) list", provides)
+		}
+	})
+}
+
+func Test_FileSystemProvider_Requires(t *testing.T) {
+	t.Run("retrieve the required entries", func(t *testing.T) {
+		if requires := NewFileSystemProvider(nil).Requires(); len(requires) != 0 {
+			t.Errorf("returned the (%v) list", requires)
+		}
+	})
+}
+
 func Test_FileSystemProvider_Register(t *testing.T) {
 	a := NewApp()
 
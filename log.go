@@ -16,17 +16,21 @@ func NewLog() *Log {
 	}
 }
 
-// Close will terminate all the logging stream associated to the logger.
+// Close will terminate all the logging stream associated to the logger,
+// reporting all the streams closing errors in the returned AppErrors error.
 func (l *Log) Close() error {
 	if l == nil {
 		panic(fmt.Errorf("nil pointer receiver"))
 	}
 
+	errs := AppErrors{}
 	for id, stream := range l.streams {
-		_ = stream.Close()
+		if err := stream.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("stream '%s' close error : %w", id, err))
+		}
 		delete(l.streams, id)
 	}
-	return nil
+	return errs.errorOrNil()
 }
 
 // Signal will propagate the channel filtered logging request
@@ -38,6 +38,17 @@ const (
 	// container logger formatter factory id.
 	EnvContainerLogFormatterFactoryID = "SERVLET_CONTAINER_LOGGER_FORMATTER_FACTORY_ID"
 
+	// LogFormatterFactoryStrategyTag defines the tag to be used as the
+	// default to mark the logger formatter factory strategies in the
+	// application container, so they can be registered in the formatter
+	// factory on the logger provider boot.
+	LogFormatterFactoryStrategyTag = "servlet.log.formatter.strategy"
+
+	// EnvLogFormatterFactoryStrategyTag defines the name of the environment
+	// variable to be checked for a overriding value for the logger formatter
+	// factory strategy tag.
+	EnvLogFormatterFactoryStrategyTag = "SERVLET_LOGGER_FORMATTER_FACTORY_STRATEGY_TAG"
+
 	// ContainerLogStreamFactoryStrategyFileID defines the id to be used as the
 	// default of a logger file stream factory strategy instance in the
 	// application container.
@@ -57,6 +68,17 @@ const (
 	// container logger stream factory id.
 	EnvContainerLogStreamFactoryID = "SERVLET_CONTAINER_LOGGER_STREAM_FACTORY_ID"
 
+	// LogStreamFactoryStrategyTag defines the tag to be used as the
+	// default to mark the logger stream factory strategies in the
+	// application container, so they can be registered in the stream
+	// factory on the logger provider boot.
+	LogStreamFactoryStrategyTag = "servlet.log.stream.strategy"
+
+	// EnvLogStreamFactoryStrategyTag defines the name of the environment
+	// variable to be checked for a overriding value for the logger stream
+	// factory strategy tag.
+	EnvLogStreamFactoryStrategyTag = "SERVLET_LOGGER_STREAM_FACTORY_STRATEGY_TAG"
+
 	// ContainerLogLoaderID defines the id to be used as the default of a
 	// logger loader instance in the application container.
 	ContainerLogLoaderID = "servlet.log.loader"
@@ -3,10 +3,13 @@ package servlet
 import (
 	"fmt"
 	"github.com/spf13/afero"
+	"reflect"
 )
 
 // LogProvider defines the default logging provider to be used on
 // the application initialization to register the logging services.
+// The logger streams are closed by the application container, so the
+// logging content is flushed after all the other services have stopped.
 type LogProvider struct {
 	params *LogProviderParams
 }
@@ -22,8 +25,31 @@ func NewLogProvider(params *LogProviderParams) *LogProvider {
 	}
 }
 
+// Provides will retrieve the list of container entries registered
+// by the provider.
+func (p LogProvider) Provides() []string {
+	return []string{
+		p.params.FormatterFactoryStrategyJSONID,
+		p.params.FormatterFactoryID,
+		p.params.StreamFactoryStrategyFileID,
+		p.params.StreamFactoryID,
+		p.params.LoggerID,
+		p.params.LoaderID,
+	}
+}
+
+// Requires will retrieve the list of container entries needed by the
+// provider, that should be registered by other providers.
+func (p LogProvider) Requires() []string {
+	return []string{
+		p.params.FileSystemID,
+		p.params.ConfigID,
+	}
+}
+
 // Register will register the logger package instances in the
-// application container.
+// application container, binding their types so they can be resolved by
+// the constructors registered with the container AddConstructor method.
 func (p LogProvider) Register(container *AppContainer) error {
 	if container == nil {
 		return fmt.Errorf("invalid nil 'container' argument")
@@ -31,19 +57,13 @@ func (p LogProvider) Register(container *AppContainer) error {
 
 	_ = container.Add(p.params.FormatterFactoryStrategyJSONID, func(container *AppContainer) (interface{}, error) {
 		return NewLogFormatterFactoryStrategyJSON(), nil
-	})
+	}, p.params.FormatterFactoryStrategyTag)
 
 	_ = container.Add(p.params.FormatterFactoryID, func(container *AppContainer) (interface{}, error) {
 		return NewLogFormatterFactory(), nil
 	})
 
-	_ = container.Add(p.params.StreamFactoryStrategyFileID, func(container *AppContainer) (strategy interface{}, err error) {
-		defer func() {
-			if r := recover(); r != nil {
-				err = r.(error)
-			}
-		}()
-
+	_ = container.Add(p.params.StreamFactoryStrategyFileID, func(container *AppContainer) (interface{}, error) {
 		fileSystem, err := container.Get(p.params.FileSystemID)
 		if err != nil {
 			return nil, err
@@ -55,7 +75,7 @@ func (p LogProvider) Register(container *AppContainer) error {
 		}
 
 		return NewLogStreamFactoryStrategyFile(fileSystem.(afero.Fs), formatterFactory.(*LogFormatterFactory))
-	})
+	}, p.params.StreamFactoryStrategyTag)
 
 	_ = container.Add(p.params.StreamFactoryID, func(container *AppContainer) (obj interface{}, err error) {
 		return NewLogStreamFactory(), nil
@@ -65,13 +85,7 @@ func (p LogProvider) Register(container *AppContainer) error {
 		return NewLog(), nil
 	})
 
-	_ = container.Add(p.params.LoaderID, func(container *AppContainer) (obj interface{}, err error) {
-		defer func() {
-			if r := recover(); r != nil {
-				err = r.(error)
-			}
-		}()
-
+	_ = container.Add(p.params.LoaderID, func(container *AppContainer) (interface{}, error) {
 		logger, err := container.Get(p.params.LoggerID)
 		if err != nil {
 			return nil, err
@@ -85,15 +99,26 @@ func (p LogProvider) Register(container *AppContainer) error {
 		return NewLogLoader(logger.(*Log), streamFactory.(*LogStreamFactory))
 	})
 
+	for id, typ := range map[string]reflect.Type{
+		p.params.FormatterFactoryID: reflect.TypeOf(&LogFormatterFactory{}),
+		p.params.StreamFactoryID:    reflect.TypeOf(&LogStreamFactory{}),
+		p.params.LoggerID:           reflect.TypeOf(&Log{}),
+		p.params.LoaderID:           reflect.TypeOf(&LogLoader{}),
+	} {
+		_ = container.Bind(typ, id)
+	}
+
 	return nil
 }
 
-// Boot will start the logger package config instance by calling the
-// logger loader with the defined provider base entry information.
+// Boot will register all the formatter and stream factory strategies tagged
+// in the application container into the respective factories, and start the
+// logger package config instance by calling the logger loader with the
+// defined provider base entry information.
 func (p LogProvider) Boot(container *AppContainer) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			err = r.(error)
+			err = panicError(r)
 		}
 	}()
 
@@ -103,12 +128,12 @@ func (p LogProvider) Boot(container *AppContainer) (err error) {
 			return err
 		}
 
-		{
-			strategy, err := container.Get(p.params.FormatterFactoryStrategyJSONID)
-			if err != nil {
-				return err
-			}
+		strategies, err := container.GetTagged(p.params.FormatterFactoryStrategyTag)
+		if err != nil {
+			return err
+		}
 
+		for _, strategy := range strategies {
 			_ = factory.(*LogFormatterFactory).Register(strategy.(LogFormatterFactoryStrategy))
 		}
 	}
@@ -119,12 +144,12 @@ func (p LogProvider) Boot(container *AppContainer) (err error) {
 			return err
 		}
 
-		{
-			strategy, err := container.Get(p.params.StreamFactoryStrategyFileID)
-			if err != nil {
-				return err
-			}
+		strategies, err := container.GetTagged(p.params.StreamFactoryStrategyTag)
+		if err != nil {
+			return err
+		}
 
+		for _, strategy := range strategies {
 			_ = factory.(*LogStreamFactory).Register(strategy.(LogStreamFactoryStrategy))
 		}
 	}
@@ -1,6 +1,9 @@
 package servlet
 
-import "os"
+import (
+	"fmt"
+	"os"
+)
 
 // LogProviderParams defines the logging provider parameters storing structure
 // that will be needed when instantiating a new provider
@@ -10,8 +13,10 @@ type LogProviderParams struct {
 	ConfigID                       string
 	FormatterFactoryStrategyJSONID string
 	FormatterFactoryID             string
+	FormatterFactoryStrategyTag    string
 	StreamFactoryStrategyFileID    string
 	StreamFactoryID                string
+	StreamFactoryStrategyTag       string
 	LoaderID                       string
 }
 
@@ -24,8 +29,10 @@ func NewLogProviderParams() *LogProviderParams {
 		ConfigID:                       ContainerConfigID,
 		FormatterFactoryStrategyJSONID: ContainerLogFormatterFactoryStrategyJSONID,
 		FormatterFactoryID:             ContainerLogFormatterFactoryID,
+		FormatterFactoryStrategyTag:    LogFormatterFactoryStrategyTag,
 		StreamFactoryStrategyFileID:    ContainerLogStreamFactoryStrategyFileID,
 		StreamFactoryID:                ContainerLogStreamFactoryID,
+		StreamFactoryStrategyTag:       LogStreamFactoryStrategyTag,
 		LoaderID:                       ContainerLogLoaderID,
 	}
 
@@ -49,6 +56,10 @@ func NewLogProviderParams() *LogProviderParams {
 		params.FormatterFactoryID = env
 	}
 
+	if env := os.Getenv(EnvLogFormatterFactoryStrategyTag); env != "" {
+		params.FormatterFactoryStrategyTag = env
+	}
+
 	if env := os.Getenv(EnvContainerLogStreamFactoryStrategyFileID); env != "" {
 		params.StreamFactoryStrategyFileID = env
 	}
@@ -57,9 +68,49 @@ func NewLogProviderParams() *LogProviderParams {
 		params.StreamFactoryID = env
 	}
 
+	if env := os.Getenv(EnvLogStreamFactoryStrategyTag); env != "" {
+		params.StreamFactoryStrategyTag = env
+	}
+
 	if env := os.Getenv(EnvContainerLogLoaderID); env != "" {
 		params.LoaderID = env
 	}
 
 	return params
 }
+
+// NewLogProviderParamsConfig will instantiate a new log provider parameters
+// storing instance with the servlet default values, overridden by the values
+// defined in the given configuration partial.
+func NewLogProviderParamsConfig(conf ConfigPartial) (params *LogProviderParams, err error) {
+	if conf == nil {
+		return nil, fmt.Errorf("invalid nil 'conf' argument")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			params, err = nil, panicError(r)
+		}
+	}()
+
+	params = NewLogProviderParams()
+
+	for path, field := range map[string]*string{
+		"logger_id":                          &params.LoggerID,
+		"file_system_id":                     &params.FileSystemID,
+		"config_id":                          &params.ConfigID,
+		"formatter_factory_strategy_json_id": &params.FormatterFactoryStrategyJSONID,
+		"formatter_factory_id":               &params.FormatterFactoryID,
+		"formatter_factory_strategy_tag":     &params.FormatterFactoryStrategyTag,
+		"stream_factory_strategy_file_id":    &params.StreamFactoryStrategyFileID,
+		"stream_factory_id":                  &params.StreamFactoryID,
+		"stream_factory_strategy_tag":        &params.StreamFactoryStrategyTag,
+		"loader_id":                          &params.LoaderID,
+	} {
+		if conf.Has(path) {
+			*field = conf.String(path)
+		}
+	}
+
+	return params, nil
+}
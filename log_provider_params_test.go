@@ -2,6 +2,8 @@ package servlet
 
 import (
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -19,10 +21,14 @@ func Test_NewLogProviderParams(t *testing.T) {
 			t.Errorf("stored (%v) formatter factory strategy json ID", value)
 		} else if value := parameters.FormatterFactoryID; value != ContainerLogFormatterFactoryID {
 			t.Errorf("stored (%v) formatter factory ID", value)
+		} else if value := parameters.FormatterFactoryStrategyTag; value != LogFormatterFactoryStrategyTag {
+			t.Errorf("stored (%v) formatter factory strategy tag", value)
 		} else if value := parameters.StreamFactoryStrategyFileID; value != ContainerLogStreamFactoryStrategyFileID {
 			t.Errorf("stored (%v) stream factory strategy file ID", value)
 		} else if value := parameters.StreamFactoryID; value != ContainerLogStreamFactoryID {
 			t.Errorf("stored (%v) stream factory ID", value)
+		} else if value := parameters.StreamFactoryStrategyTag; value != LogStreamFactoryStrategyTag {
+			t.Errorf("stored (%v) stream factory strategy tag", value)
 		} else if value := parameters.LoaderID; value != ContainerLogLoaderID {
 			t.Errorf("stored (%v) loader ID", value)
 		}
@@ -115,4 +121,89 @@ func Test_NewLogProviderParams(t *testing.T) {
 			t.Errorf("stored (%v) loader ID", check)
 		}
 	})
+
+	t.Run("with the env formatter factory strategy tag", func(t *testing.T) {
+		value := "formatter_strategy_tag"
+		_ = os.Setenv(EnvLogFormatterFactoryStrategyTag, value)
+		defer func() { _ = os.Setenv(EnvLogFormatterFactoryStrategyTag, "") }()
+
+		parameters := NewLogProviderParams()
+		if check := parameters.FormatterFactoryStrategyTag; check != value {
+			t.Errorf("stored (%v) formatter factory strategy tag", check)
+		}
+	})
+
+	t.Run("with the env stream factory strategy tag", func(t *testing.T) {
+		value := "stream_strategy_tag"
+		_ = os.Setenv(EnvLogStreamFactoryStrategyTag, value)
+		defer func() { _ = os.Setenv(EnvLogStreamFactoryStrategyTag, "") }()
+
+		parameters := NewLogProviderParams()
+		if check := parameters.StreamFactoryStrategyTag; check != value {
+			t.Errorf("stored (%v) stream factory strategy tag", check)
+		}
+	})
+}
+
+func Test_NewLogProviderParamsConfig(t *testing.T) {
+	t.Run("nil config", func(t *testing.T) {
+		if params, err := NewLogProviderParamsConfig(nil); params != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'conf' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("invalid config value", func(t *testing.T) {
+		if params, err := NewLogProviderParamsConfig(ConfigPartial{"logger_id": 123}); params != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !strings.HasPrefix(err.Error(), "interface conversion") {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("with the default values", func(t *testing.T) {
+		if params, err := NewLogProviderParamsConfig(ConfigPartial{}); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !reflect.DeepEqual(params, NewLogProviderParams()) {
+			t.Errorf("returned the (%v) params", params)
+		}
+	})
+
+	t.Run("with the config values", func(t *testing.T) {
+		conf := ConfigPartial{
+			"logger_id":                          "logger_id",
+			"file_system_id":                     "file_system_id",
+			"config_id":                          "config_id",
+			"formatter_factory_strategy_json_id": "formatter_factory_strategy_json_id",
+			"formatter_factory_id":               "formatter_factory_id",
+			"formatter_factory_strategy_tag":     "formatter_factory_strategy_tag",
+			"stream_factory_strategy_file_id":    "stream_factory_strategy_file_id",
+			"stream_factory_id":                  "stream_factory_id",
+			"stream_factory_strategy_tag":        "stream_factory_strategy_tag",
+			"loader_id":                          "loader_id",
+		}
+		expected := &LogProviderParams{
+			LoggerID:                       "logger_id",
+			FileSystemID:                   "file_system_id",
+			ConfigID:                       "config_id",
+			FormatterFactoryStrategyJSONID: "formatter_factory_strategy_json_id",
+			FormatterFactoryID:             "formatter_factory_id",
+			FormatterFactoryStrategyTag:    "formatter_factory_strategy_tag",
+			StreamFactoryStrategyFileID:    "stream_factory_strategy_file_id",
+			StreamFactoryID:                "stream_factory_id",
+			StreamFactoryStrategyTag:       "stream_factory_strategy_tag",
+			LoaderID:                       "loader_id",
+		}
+
+		if params, err := NewLogProviderParamsConfig(conf); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !reflect.DeepEqual(params, expected) {
+			t.Errorf("returned the (%v) params", params)
+		}
+	})
 }
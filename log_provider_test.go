@@ -1,7 +1,9 @@
 package servlet
 
 import (
+	"errors"
 	"fmt"
+	"github.com/golang/mock/gomock"
 	"reflect"
 	"strings"
 	"testing"
@@ -26,6 +28,33 @@ func Test_NewLogProvider(t *testing.T) {
 	})
 }
 
+func Test_LogProvider_Provides(t *testing.T) {
+	t.Run("retrieve the provided entries", func(t *testing.T) {
+		expected := []string{
+			ContainerLogFormatterFactoryStrategyJSONID,
+			ContainerLogFormatterFactoryID,
+			ContainerLogStreamFactoryStrategyFileID,
+			ContainerLogStreamFactoryID,
+			ContainerLoggerID,
+			ContainerLogLoaderID,
+		}
+
+		if provides := NewLogProvider(nil).Provides(); !reflect.DeepEqual(provides, expected) {
+			t.Errorf("returned the (%v) list", provides)
+		}
+	})
+}
+
+func Test_LogProvider_Requires(t *testing.T) {
+	t.Run("retrieve the required entries", func(t *testing.T) {
+		expected := []string{ContainerFileSystemID, ContainerConfigID}
+
+		if requires := NewLogProvider(nil).Requires(); !reflect.DeepEqual(requires, expected) {
+			t.Errorf("returned the (%v) list", requires)
+		}
+	})
+}
+
 func Test_LogProvider_Register(t *testing.T) {
 	t.Run("nil container", func(t *testing.T) {
 		provider := NewLogProvider(nil)
@@ -105,7 +134,7 @@ func Test_LogProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -124,7 +153,7 @@ func Test_LogProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if strings.Index(err.Error(), "interface conversion") != 0 {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.Contains(err.Error(), "interface conversion") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -143,7 +172,7 @@ func Test_LogProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -162,7 +191,7 @@ func Test_LogProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if strings.Index(err.Error(), "interface conversion") != 0 {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.Contains(err.Error(), "interface conversion") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -219,7 +248,7 @@ func Test_LogProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -238,7 +267,7 @@ func Test_LogProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if strings.Index(err.Error(), "interface conversion") != 0 {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.Contains(err.Error(), "interface conversion") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -257,7 +286,7 @@ func Test_LogProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -276,7 +305,7 @@ func Test_LogProvider_Register(t *testing.T) {
 			t.Error("returned a valid reference")
 		} else if err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if strings.Index(err.Error(), "interface conversion") != 0 {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.Contains(err.Error(), "interface conversion") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -299,6 +328,27 @@ func Test_LogProvider_Register(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("close the logger streams on the container close", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		container := NewAppContainer()
+		provider := NewLogProvider(nil)
+		_ = provider.Register(container)
+
+		stream := NewMockLogStream(ctrl)
+		stream.EXPECT().Close().Return(nil).Times(1)
+
+		logger, _ := container.Get(ContainerLoggerID)
+		_ = logger.(*Log).AddStream("id", stream)
+
+		if err := container.Close(); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if logger.(*Log).HasStream("id") {
+			t.Error("didn't removed the logger stream")
+		}
+	})
 }
 
 func Test_LogProvider_Boot(t *testing.T) {
@@ -313,7 +363,7 @@ func Test_LogProvider_Boot(t *testing.T) {
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -343,11 +393,11 @@ func Test_LogProvider_Boot(t *testing.T) {
 
 		_ = container.Add(ContainerLogFormatterFactoryStrategyJSONID, func(*AppContainer) (interface{}, error) {
 			return nil, fmt.Errorf("error")
-		})
+		}, LogFormatterFactoryStrategyTag)
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -360,7 +410,7 @@ func Test_LogProvider_Boot(t *testing.T) {
 
 		_ = container.Add(ContainerLogFormatterFactoryStrategyJSONID, func(*AppContainer) (interface{}, error) {
 			return "string", nil
-		})
+		}, LogFormatterFactoryStrategyTag)
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error", err)
@@ -381,7 +431,7 @@ func Test_LogProvider_Boot(t *testing.T) {
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -411,11 +461,11 @@ func Test_LogProvider_Boot(t *testing.T) {
 
 		_ = container.Add(ContainerLogStreamFactoryStrategyFileID, func(*AppContainer) (interface{}, error) {
 			return nil, fmt.Errorf("error")
-		})
+		}, LogStreamFactoryStrategyTag)
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -428,7 +478,7 @@ func Test_LogProvider_Boot(t *testing.T) {
 
 		_ = container.Add(ContainerLogStreamFactoryStrategyFileID, func(*AppContainer) (interface{}, error) {
 			return "string", nil
-		})
+		}, LogStreamFactoryStrategyTag)
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error", err)
@@ -449,7 +499,7 @@ func Test_LogProvider_Boot(t *testing.T) {
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -485,7 +535,7 @@ func Test_LogProvider_Boot(t *testing.T) {
 
 		if err := provider.Boot(container); err == nil {
 			t.Error("didn't returned the expected error", err)
-		} else if err.Error() != "error" {
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
 			t.Errorf("returned the (%v) error", err)
 		}
 	})
@@ -508,6 +558,40 @@ func Test_LogProvider_Boot(t *testing.T) {
 		}
 	})
 
+	t.Run("register the tagged third-party strategies", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		formatterStrategy := NewMockLogFormatterFactoryStrategy(ctrl)
+		streamStrategy := NewMockLogStreamFactoryStrategy(ctrl)
+
+		container := NewAppContainer()
+		_ = NewFileSystemProvider(nil).Register(container)
+		_ = NewConfigProvider(nil).Register(container)
+		provider := NewLogProvider(nil)
+		_ = provider.Register(container)
+
+		_ = container.Add("formatter.strategy", func(*AppContainer) (interface{}, error) {
+			return formatterStrategy, nil
+		}, LogFormatterFactoryStrategyTag)
+		_ = container.Add("stream.strategy", func(*AppContainer) (interface{}, error) {
+			return streamStrategy, nil
+		}, LogStreamFactoryStrategyTag)
+
+		if err := provider.Boot(container); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		}
+
+		formatterFactory, _ := container.Get(ContainerLogFormatterFactoryID)
+		streamFactory, _ := container.Get(ContainerLogStreamFactoryID)
+
+		if strategies := formatterFactory.(*LogFormatterFactory).strategies; len(strategies) != 2 || strategies[0] != formatterStrategy {
+			t.Error("didn't registered the tagged formatter factory strategy")
+		} else if strategies := streamFactory.(*LogStreamFactory).strategies; len(strategies) != 2 || strategies[0] != streamStrategy {
+			t.Error("didn't registered the tagged stream factory strategy")
+		}
+	})
+
 	t.Run("run boot log loader", func(t *testing.T) {
 		container := NewAppContainer()
 		_ = NewFileSystemProvider(nil).Register(container)
@@ -61,6 +61,26 @@ func Test_Log_Close(t *testing.T) {
 			t.Error("didn't removed the stream")
 		}
 	})
+	t.Run("report the streams close errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		logger := NewLog()
+
+		id := "stream"
+		expected := fmt.Errorf("error")
+		stream := NewMockLogStream(ctrl)
+		stream.EXPECT().Close().Return(expected).Times(1)
+		_ = logger.AddStream(id, stream)
+
+		if err := logger.Close(); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "stream 'stream' close error : error" {
+			t.Errorf("returned the (%v) error", err)
+		} else if logger.HasStream(id) {
+			t.Error("didn't removed the stream")
+		}
+	})
 }
 
 func Test_Log_Signal(t *testing.T) {
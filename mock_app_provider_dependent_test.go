@@ -0,0 +1,61 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: app_provider_dependent.go
+
+// Package servlet is a generated GoMock package.
+package servlet
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockAppProviderDependent is a mock of AppProviderDependent interface
+type MockAppProviderDependent struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppProviderDependentMockRecorder
+}
+
+// MockAppProviderDependentMockRecorder is the mock recorder for MockAppProviderDependent
+type MockAppProviderDependentMockRecorder struct {
+	mock *MockAppProviderDependent
+}
+
+// NewMockAppProviderDependent creates a new mock instance
+func NewMockAppProviderDependent(ctrl *gomock.Controller) *MockAppProviderDependent {
+	mock := &MockAppProviderDependent{ctrl: ctrl}
+	mock.recorder = &MockAppProviderDependentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAppProviderDependent) EXPECT() *MockAppProviderDependentMockRecorder {
+	return m.recorder
+}
+
+// Provides mocks base method
+func (m *MockAppProviderDependent) Provides() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Provides")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Provides indicates an expected call of Provides
+func (mr *MockAppProviderDependentMockRecorder) Provides() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Provides", reflect.TypeOf((*MockAppProviderDependent)(nil).Provides))
+}
+
+// Requires mocks base method
+func (m *MockAppProviderDependent) Requires() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Requires")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Requires indicates an expected call of Requires
+func (mr *MockAppProviderDependentMockRecorder) Requires() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Requires", reflect.TypeOf((*MockAppProviderDependent)(nil).Requires))
+}
@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: app_provider_shutdown.go
+
+// Package servlet is a generated GoMock package.
+package servlet
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockAppProviderShutdown is a mock of AppProviderShutdown interface
+type MockAppProviderShutdown struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppProviderShutdownMockRecorder
+}
+
+// MockAppProviderShutdownMockRecorder is the mock recorder for MockAppProviderShutdown
+type MockAppProviderShutdownMockRecorder struct {
+	mock *MockAppProviderShutdown
+}
+
+// NewMockAppProviderShutdown creates a new mock instance
+func NewMockAppProviderShutdown(ctrl *gomock.Controller) *MockAppProviderShutdown {
+	mock := &MockAppProviderShutdown{ctrl: ctrl}
+	mock.recorder = &MockAppProviderShutdownMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAppProviderShutdown) EXPECT() *MockAppProviderShutdownMockRecorder {
+	return m.recorder
+}
+
+// Shutdown mocks base method
+func (m *MockAppProviderShutdown) Shutdown(arg0 context.Context, arg1 *AppContainer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Shutdown", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Shutdown indicates an expected call of Shutdown
+func (mr *MockAppProviderShutdownMockRecorder) Shutdown(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockAppProviderShutdown)(nil).Shutdown), arg0, arg1)
+}
@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: app_runner.go
+
+// Package servlet is a generated GoMock package.
+package servlet
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockAppRunner is a mock of AppRunner interface
+type MockAppRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppRunnerMockRecorder
+}
+
+// MockAppRunnerMockRecorder is the mock recorder for MockAppRunner
+type MockAppRunnerMockRecorder struct {
+	mock *MockAppRunner
+}
+
+// NewMockAppRunner creates a new mock instance
+func NewMockAppRunner(ctrl *gomock.Controller) *MockAppRunner {
+	mock := &MockAppRunner{ctrl: ctrl}
+	mock.recorder = &MockAppRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAppRunner) EXPECT() *MockAppRunnerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method
+func (m *MockAppRunner) Run(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run
+func (mr *MockAppRunnerMockRecorder) Run(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockAppRunner)(nil).Run), arg0)
+}
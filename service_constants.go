@@ -0,0 +1,33 @@
+package servlet
+
+const (
+	// ServiceArgumentService defines the field name of a service definition
+	// argument that references a container object by its id.
+	ServiceArgumentService = "service"
+
+	// ServiceArgumentConfig defines the field name of a service definition
+	// argument that references a configuration path.
+	ServiceArgumentConfig = "config"
+
+	// ServiceArgumentValue defines the field name of a service definition
+	// argument that holds a literal value.
+	ServiceArgumentValue = "value"
+
+	// ContainerServiceRegistryID defines the id to be used as the default of
+	// a service constructor registry instance in the application container.
+	ContainerServiceRegistryID = "servlet.service.registry"
+
+	// EnvContainerServiceRegistryID defines the name of the environment
+	// variable to be checked for a overriding value for the application
+	// container service constructor registry id.
+	EnvContainerServiceRegistryID = "SERVLET_CONTAINER_SERVICE_REGISTRY_ID"
+
+	// ContainerServiceLoaderID defines the id to be used as the default of
+	// a service definitions loader instance in the application container.
+	ContainerServiceLoaderID = "servlet.service.loader"
+
+	// EnvContainerServiceLoaderID defines the name of the environment
+	// variable to be checked for a overriding value for the application
+	// container service definitions loader id.
+	EnvContainerServiceLoaderID = "SERVLET_CONTAINER_SERVICE_LOADER_ID"
+)
@@ -0,0 +1,188 @@
+package servlet
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type serviceArgument func(container *AppContainer) (interface{}, error)
+
+// ServiceLoader defines the container objects instantiation from the
+// service definitions of the configuration.
+type ServiceLoader struct {
+	container *AppContainer
+	registry  *ServiceRegistry
+}
+
+// NewServiceLoader create a new service definitions loader instance.
+func NewServiceLoader(container *AppContainer, registry *ServiceRegistry) (*ServiceLoader, error) {
+	if container == nil {
+		return nil, fmt.Errorf("invalid nil 'container' argument")
+	}
+	if registry == nil {
+		return nil, fmt.Errorf("invalid nil 'registry' argument")
+	}
+
+	return &ServiceLoader{
+		container: container,
+		registry:  registry,
+	}, nil
+}
+
+// Load will parse the service definitions stored in the configuration
+// services list and register the defined objects in the container.
+// Each definition declares the object id, the name of the registry
+// constructor used to create the object, the object lifetime, the tags and
+// the list of the constructor arguments. Each argument can reference a
+// container object (service), a configuration path (config), or define a
+// literal value (value).
+//
+//	services:
+//	  - id: cache
+//	    constructor: cache.memory
+//	    lifetime: singleton
+//	    tags: [cache]
+//	    arguments:
+//	      - service: servlet.log
+//	      - config: cache.ttl
+//	      - value: 1024
+func (l ServiceLoader) Load(c *Config) (err error) {
+	if c == nil {
+		return fmt.Errorf("invalid nil 'config' argument")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicError(r)
+		}
+	}()
+
+	entries := c.Get("services")
+	if entries == nil {
+		return nil
+	}
+
+	for _, entry := range entries.([]interface{}) {
+		if err = l.load(c, entry.(ConfigPartial)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l ServiceLoader) load(c *Config, def ConfigPartial) (err error) {
+	id := def.String("id")
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicError(r)
+		}
+		if err != nil {
+			err = fmt.Errorf("service '%s' definition error : %w", id, err)
+		}
+	}()
+
+	constructor, err := l.registry.Get(def.String("constructor"))
+	if err != nil {
+		return err
+	}
+
+	name := def.String("lifetime", AppContainerSingleton.String())
+	lifetime, ok := AppContainerLifetimeMap[name]
+	if !ok {
+		return fmt.Errorf("invalid '%s' lifetime", name)
+	}
+
+	var tags []string
+	for _, tag := range def.Get("tags", []interface{}{}).([]interface{}) {
+		tags = append(tags, tag.(string))
+	}
+
+	var args []serviceArgument
+	for _, arg := range def.Get("arguments", []interface{}{}).([]interface{}) {
+		resolver, err := l.argument(c, arg.(ConfigPartial))
+		if err != nil {
+			return err
+		}
+		args = append(args, resolver)
+	}
+
+	fn := reflect.ValueOf(constructor)
+	fnType := fn.Type()
+	if len(args) != fnType.NumIn() {
+		return fmt.Errorf("invalid number of arguments (%d) for the '%T' constructor", len(args), constructor)
+	}
+
+	factory := func(container *AppContainer) (interface{}, error) {
+		values := make([]reflect.Value, len(args))
+		for i, arg := range args {
+			value, err := arg(container)
+			if err != nil {
+				return nil, fmt.Errorf("argument '%d' resolution error : %w", i, err)
+			}
+
+			if values[i], err = serviceArgumentValue(value, fnType.In(i)); err != nil {
+				return nil, fmt.Errorf("argument '%d' resolution error : %w", i, err)
+			}
+		}
+
+		return construct(fn, values)
+	}
+
+	return l.container.add(id, factory, lifetime, fnType.Out(0), tags)
+}
+
+func (l ServiceLoader) argument(c *Config, def ConfigPartial) (serviceArgument, error) {
+	if len(def) != 1 {
+		return nil, fmt.Errorf("invalid argument definition : %v", def)
+	}
+
+	for field, value := range def {
+		switch field {
+		case ServiceArgumentService:
+			id := value.(string)
+			return func(container *AppContainer) (interface{}, error) {
+				return container.Get(id)
+			}, nil
+		case ServiceArgumentConfig:
+			path := value.(string)
+			return func(*AppContainer) (interface{}, error) {
+				if !c.Has(path) {
+					return nil, fmt.Errorf("config path '%s' not found", path)
+				}
+				return c.Get(path), nil
+			}, nil
+		case ServiceArgumentValue:
+			return func(*AppContainer) (interface{}, error) {
+				return value, nil
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid argument definition : %v", def)
+}
+
+func serviceArgumentValue(value interface{}, typ reflect.Type) (reflect.Value, error) {
+	v := reflect.ValueOf(value)
+	switch {
+	case !v.IsValid():
+		switch typ.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+			return reflect.Zero(typ), nil
+		}
+	case v.Type().AssignableTo(typ):
+		return v, nil
+	case serviceArgumentNumeric(v.Kind()) && serviceArgumentNumeric(typ.Kind()):
+		return v.Convert(typ), nil
+	}
+	return reflect.Value{}, fmt.Errorf("value of type '%T' is not assignable to '%s'", value, typ)
+}
+
+func serviceArgumentNumeric(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,271 @@
+package servlet
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"strings"
+	"testing"
+	"time"
+)
+
+type serviceLoaderTarget struct {
+	dependency interface{}
+	name       string
+	size       int64
+	timeout    time.Duration
+}
+
+func newServiceLoaderTarget(dependency interface{}, name string, size int64, timeout time.Duration) *serviceLoaderTarget {
+	return &serviceLoaderTarget{dependency: dependency, name: name, size: size, timeout: timeout}
+}
+
+func serviceLoaderConfigSource(ctrl *gomock.Controller, conf ConfigPartial) ConfigSource {
+	source := NewMockConfigSource(ctrl)
+	source.EXPECT().Get("").Return(conf).AnyTimes()
+	source.EXPECT().Close().AnyTimes()
+	return source
+}
+
+func serviceLoaderConfig(ctrl *gomock.Controller, services ...interface{}) *Config {
+	config, _ := NewConfig(0 * time.Second)
+	_ = config.AddSource("source", 0, serviceLoaderConfigSource(ctrl, ConfigPartial{"services": services}))
+	return config
+}
+
+func Test_NewServiceLoader(t *testing.T) {
+	t.Run("error when missing the container", func(t *testing.T) {
+		if loader, err := NewServiceLoader(nil, NewServiceRegistry()); loader != nil {
+			t.Errorf("return a valid reference")
+		} else if err == nil {
+			t.Errorf("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'container' argument" {
+			t.Errorf("returned the (%v)) error", err)
+		}
+	})
+
+	t.Run("error when missing the registry", func(t *testing.T) {
+		if loader, err := NewServiceLoader(NewAppContainer(), nil); loader != nil {
+			t.Errorf("return a valid reference")
+		} else if err == nil {
+			t.Errorf("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'registry' argument" {
+			t.Errorf("returned the (%v)) error", err)
+		}
+	})
+
+	t.Run("create loader", func(t *testing.T) {
+		if loader, err := NewServiceLoader(NewAppContainer(), NewServiceRegistry()); loader == nil {
+			t.Errorf("didn't returned a valid reference")
+		} else if err != nil {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+}
+
+func Test_ServiceLoader_Load(t *testing.T) {
+	t.Run("nil config", func(t *testing.T) {
+		loader, _ := NewServiceLoader(NewAppContainer(), NewServiceRegistry())
+
+		if err := loader.Load(nil); err == nil {
+			t.Errorf("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'config' argument" {
+			t.Errorf("returned the (%s) error", err)
+		}
+	})
+
+	t.Run("no-op if service list is missing", func(t *testing.T) {
+		container := NewAppContainer()
+		loader, _ := NewServiceLoader(container, NewServiceRegistry())
+		config, _ := NewConfig(0 * time.Second)
+
+		if err := loader.Load(config); err != nil {
+			t.Errorf("returned the (%s) error", err)
+		} else if len(container.Describe()) != 0 {
+			t.Error("registered unexpected entries")
+		}
+	})
+
+	t.Run("invalid service definition", func(t *testing.T) {
+		scenarios := []struct {
+			services []interface{}
+			expected string
+		}{
+			{ // test non-partial definition
+				services: []interface{}{"string"},
+				expected: "interface conversion",
+			},
+			{ // test missing id
+				services: []interface{}{ConfigPartial{"constructor": "target"}},
+				expected: "interface conversion",
+			},
+			{ // test unknown constructor
+				services: []interface{}{ConfigPartial{"id": "id", "constructor": "unknown"}},
+				expected: "service 'id' definition error : constructor 'unknown' not registered",
+			},
+			{ // test unknown lifetime
+				services: []interface{}{ConfigPartial{"id": "id", "constructor": "target", "lifetime": "forever"}},
+				expected: "service 'id' definition error : invalid 'forever' lifetime",
+			},
+			{ // test non-string tag
+				services: []interface{}{ConfigPartial{"id": "id", "constructor": "target", "tags": []interface{}{123}}},
+				expected: "service 'id' definition error : interface conversion",
+			},
+			{ // test unknown argument type
+				services: []interface{}{ConfigPartial{"id": "id", "constructor": "target", "arguments": []interface{}{
+					ConfigPartial{"other": "value"},
+				}}},
+				expected: "service 'id' definition error : invalid argument definition",
+			},
+			{ // test ambiguous argument
+				services: []interface{}{ConfigPartial{"id": "id", "constructor": "target", "arguments": []interface{}{
+					ConfigPartial{"service": "id", "value": "value"},
+				}}},
+				expected: "service 'id' definition error : invalid argument definition",
+			},
+			{ // test invalid number of arguments
+				services: []interface{}{ConfigPartial{"id": "id", "constructor": "target", "arguments": []interface{}{
+					ConfigPartial{"value": "value"},
+				}}},
+				expected: "service 'id' definition error : invalid number of arguments (1)",
+			},
+		}
+
+		for _, scn := range scenarios {
+			ctrl := gomock.NewController(t)
+
+			container := NewAppContainer()
+			registry := NewServiceRegistry()
+			_ = registry.Register("target", newServiceLoaderTarget)
+			loader, _ := NewServiceLoader(container, registry)
+
+			if err := loader.Load(serviceLoaderConfig(ctrl, scn.services...)); err == nil {
+				t.Error("didn't returned the expected error")
+			} else if !strings.HasPrefix(err.Error(), scn.expected) {
+				t.Errorf("returned the (%v) error", err)
+			}
+
+			ctrl.Finish()
+		}
+	})
+
+	t.Run("register the defined service", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		container := NewAppContainer()
+		defer container.Close()
+
+		_ = container.Add("dependency", func(*AppContainer) (interface{}, error) {
+			return "dependency", nil
+		})
+
+		registry := NewServiceRegistry()
+		_ = registry.Register("target", newServiceLoaderTarget)
+		loader, _ := NewServiceLoader(container, registry)
+
+		config := serviceLoaderConfig(ctrl, ConfigPartial{
+			"id":          "id",
+			"constructor": "target",
+			"lifetime":    "transient",
+			"tags":        []interface{}{"tag"},
+			"arguments": []interface{}{
+				ConfigPartial{"service": "dependency"},
+				ConfigPartial{"config": "name"},
+				ConfigPartial{"value": 1024},
+				ConfigPartial{"value": 10},
+			},
+		})
+		_ = config.AddSource("values", 1, serviceLoaderConfigSource(ctrl, ConfigPartial{"name": "target"}))
+
+		if err := loader.Load(config); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if tagged, _ := container.GetTagged("tag"); len(tagged) != 1 {
+			t.Error("didn't tagged the defined service")
+		} else if target := tagged[0].(*serviceLoaderTarget); target.dependency != "dependency" {
+			t.Errorf("injected the (%v) dependency", target.dependency)
+		} else if target.name != "target" {
+			t.Errorf("injected the (%v) config value", target.name)
+		} else if target.size != 1024 {
+			t.Errorf("injected the (%v) value", target.size)
+		} else if target.timeout != time.Duration(10) {
+			t.Errorf("injected the (%v) converted value", target.timeout)
+		} else if first, _ := container.Get("id"); first == tagged[0] {
+			t.Error("didn't registered the service with the defined lifetime")
+		} else if descriptions := container.Describe(); descriptions[1].Type != "*servlet.serviceLoaderTarget" {
+			t.Errorf("registered the service with the (%v) type", descriptions[1].Type)
+		}
+	})
+
+	t.Run("error resolving the service arguments", func(t *testing.T) {
+		scenarios := []struct {
+			argument interface{}
+			expected error
+			message  string
+		}{
+			{ // test non-registered service argument
+				argument: ConfigPartial{"service": "unknown"},
+				expected: ErrNotRegistered,
+				message:  "argument '0' resolution error : entry 'unknown' not registered in the container",
+			},
+			{ // test non-existing config path argument
+				argument: ConfigPartial{"config": "unknown"},
+				message:  "argument '0' resolution error : config path 'unknown' not found",
+			},
+			{ // test non-assignable argument value
+				argument: ConfigPartial{"value": "string"},
+				message:  "argument '0' resolution error : value of type 'string' is not assignable to 'int'",
+			},
+		}
+
+		for _, scn := range scenarios {
+			ctrl := gomock.NewController(t)
+
+			container := NewAppContainer()
+			registry := NewServiceRegistry()
+			_ = registry.Register("target", func(value int) int { return value })
+			loader, _ := NewServiceLoader(container, registry)
+
+			_ = loader.Load(serviceLoaderConfig(ctrl, ConfigPartial{
+				"id":          "id",
+				"constructor": "target",
+				"arguments":   []interface{}{scn.argument},
+			}))
+
+			if _, err := container.Get("id"); err == nil {
+				t.Error("didn't returned the expected error")
+			} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), scn.message) {
+				t.Errorf("returned the (%v) error", err)
+			} else if scn.expected != nil && !errors.Is(err, scn.expected) {
+				t.Errorf("returned the (%v) error", err)
+			}
+
+			_ = container.Close()
+			ctrl.Finish()
+		}
+	})
+
+	t.Run("return the constructor error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := fmt.Errorf("error")
+
+		container := NewAppContainer()
+		registry := NewServiceRegistry()
+		_ = registry.Register("target", func(value interface{}) (interface{}, error) { return nil, expected })
+		loader, _ := NewServiceLoader(container, registry)
+
+		_ = loader.Load(serviceLoaderConfig(ctrl, ConfigPartial{
+			"id":          "id",
+			"constructor": "target",
+			"arguments":   []interface{}{ConfigPartial{"value": nil}},
+		}))
+
+		if _, err := container.Get("id"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !errors.Is(err, expected) {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+}
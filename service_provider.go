@@ -0,0 +1,88 @@
+package servlet
+
+import (
+	"fmt"
+)
+
+// ServiceProvider defines the default service definitions provider to be
+// used on the application initialization to register the container objects
+// declared in the configuration.
+// The constructors referenced by the definitions should be registered in
+// the provider registry by the providers that require the registry id, so
+// they are registered prior the definitions loading on the provider boot.
+type ServiceProvider struct {
+	params *ServiceProviderParams
+}
+
+// NewServiceProvider will create a new service provider instance.
+func NewServiceProvider(params *ServiceProviderParams) *ServiceProvider {
+	if params == nil {
+		params = NewServiceProviderParams()
+	}
+
+	return &ServiceProvider{
+		params: params,
+	}
+}
+
+// Provides will retrieve the list of container entries registered
+// by the provider.
+func (p ServiceProvider) Provides() []string {
+	return []string{
+		p.params.RegistryID,
+		p.params.LoaderID,
+	}
+}
+
+// Requires will retrieve the list of container entries needed by the
+// provider, that should be registered by other providers.
+func (p ServiceProvider) Requires() []string {
+	return []string{
+		p.params.ConfigID,
+	}
+}
+
+// Register will register the service definitions registry and loader
+// instances in the application container.
+func (p ServiceProvider) Register(container *AppContainer) error {
+	if container == nil {
+		return fmt.Errorf("invalid nil 'container' argument")
+	}
+
+	_ = container.Add(p.params.RegistryID, func(container *AppContainer) (interface{}, error) {
+		return NewServiceRegistry(), nil
+	})
+
+	_ = container.Add(p.params.LoaderID, func(container *AppContainer) (interface{}, error) {
+		registry, err := container.Get(p.params.RegistryID)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewServiceLoader(container, registry.(*ServiceRegistry))
+	})
+
+	return nil
+}
+
+// Boot will register in the application container the objects declared
+// by the service definitions of the configuration.
+func (p ServiceProvider) Boot(container *AppContainer) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicError(r)
+		}
+	}()
+
+	loader, err := container.Get(p.params.LoaderID)
+	if err != nil {
+		return err
+	}
+
+	config, err := container.Get(p.params.ConfigID)
+	if err != nil {
+		return err
+	}
+
+	return loader.(*ServiceLoader).Load(config.(*Config))
+}
@@ -0,0 +1,67 @@
+package servlet
+
+import (
+	"fmt"
+	"os"
+)
+
+// ServiceProviderParams defines the service provider parameters storing
+// structure that will be needed when instantiating a new provider
+type ServiceProviderParams struct {
+	ConfigID   string
+	RegistryID string
+	LoaderID   string
+}
+
+// NewServiceProviderParams will instantiate a new service provider
+// parameters storing instance with the servlet default values.
+func NewServiceProviderParams() *ServiceProviderParams {
+	params := &ServiceProviderParams{
+		ConfigID:   ContainerConfigID,
+		RegistryID: ContainerServiceRegistryID,
+		LoaderID:   ContainerServiceLoaderID,
+	}
+
+	if env := os.Getenv(EnvContainerConfigID); env != "" {
+		params.ConfigID = env
+	}
+
+	if env := os.Getenv(EnvContainerServiceRegistryID); env != "" {
+		params.RegistryID = env
+	}
+
+	if env := os.Getenv(EnvContainerServiceLoaderID); env != "" {
+		params.LoaderID = env
+	}
+
+	return params
+}
+
+// NewServiceProviderParamsConfig will instantiate a new service provider
+// parameters storing instance with the servlet default values, overridden
+// by the values defined in the given configuration partial.
+func NewServiceProviderParamsConfig(conf ConfigPartial) (params *ServiceProviderParams, err error) {
+	if conf == nil {
+		return nil, fmt.Errorf("invalid nil 'conf' argument")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			params, err = nil, panicError(r)
+		}
+	}()
+
+	params = NewServiceProviderParams()
+
+	for path, field := range map[string]*string{
+		"config_id":   &params.ConfigID,
+		"registry_id": &params.RegistryID,
+		"loader_id":   &params.LoaderID,
+	} {
+		if conf.Has(path) {
+			*field = conf.String(path)
+		}
+	}
+
+	return params, nil
+}
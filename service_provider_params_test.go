@@ -0,0 +1,96 @@
+package servlet
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_NewServiceProviderParams(t *testing.T) {
+	t.Run("new parameters", func(t *testing.T) {
+		parameters := NewServiceProviderParams()
+
+		if value := parameters.ConfigID; value != ContainerConfigID {
+			t.Errorf("stored (%v) config ID", value)
+		} else if value := parameters.RegistryID; value != ContainerServiceRegistryID {
+			t.Errorf("stored (%v) registry ID", value)
+		} else if value := parameters.LoaderID; value != ContainerServiceLoaderID {
+			t.Errorf("stored (%v) loader ID", value)
+		}
+	})
+
+	t.Run("with the env config ID", func(t *testing.T) {
+		value := "config_id"
+		_ = os.Setenv(EnvContainerConfigID, value)
+		defer func() { _ = os.Setenv(EnvContainerConfigID, "") }()
+
+		parameters := NewServiceProviderParams()
+		if check := parameters.ConfigID; check != value {
+			t.Errorf("stored (%v) config ID", check)
+		}
+	})
+
+	t.Run("with the env registry ID", func(t *testing.T) {
+		value := "registry_id"
+		_ = os.Setenv(EnvContainerServiceRegistryID, value)
+		defer func() { _ = os.Setenv(EnvContainerServiceRegistryID, "") }()
+
+		parameters := NewServiceProviderParams()
+		if check := parameters.RegistryID; check != value {
+			t.Errorf("stored (%v) registry ID", check)
+		}
+	})
+
+	t.Run("with the env loader ID", func(t *testing.T) {
+		value := "loader_id"
+		_ = os.Setenv(EnvContainerServiceLoaderID, value)
+		defer func() { _ = os.Setenv(EnvContainerServiceLoaderID, "") }()
+
+		parameters := NewServiceProviderParams()
+		if check := parameters.LoaderID; check != value {
+			t.Errorf("stored (%v) loader ID", check)
+		}
+	})
+}
+
+func Test_NewServiceProviderParamsConfig(t *testing.T) {
+	t.Run("nil config", func(t *testing.T) {
+		if params, err := NewServiceProviderParamsConfig(nil); params != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'conf' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("invalid config value", func(t *testing.T) {
+		if params, err := NewServiceProviderParamsConfig(ConfigPartial{"loader_id": 123}); params != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !strings.HasPrefix(err.Error(), "interface conversion") {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("with the default values", func(t *testing.T) {
+		if params, err := NewServiceProviderParamsConfig(ConfigPartial{}); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !reflect.DeepEqual(params, NewServiceProviderParams()) {
+			t.Errorf("returned the (%v) params", params)
+		}
+	})
+
+	t.Run("with the config values", func(t *testing.T) {
+		conf := ConfigPartial{"config_id": "config_id", "registry_id": "registry_id", "loader_id": "loader_id"}
+		expected := &ServiceProviderParams{ConfigID: "config_id", RegistryID: "registry_id", LoaderID: "loader_id"}
+
+		if params, err := NewServiceProviderParamsConfig(conf); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !reflect.DeepEqual(params, expected) {
+			t.Errorf("returned the (%v) params", params)
+		}
+	})
+}
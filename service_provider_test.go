@@ -0,0 +1,239 @@
+package servlet
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_NewServiceProvider(t *testing.T) {
+	t.Run("without params", func(t *testing.T) {
+		if provider := NewServiceProvider(nil); provider == nil {
+			t.Error("didn't returned a valid reference")
+		} else if !reflect.DeepEqual(NewServiceProviderParams(), provider.params) {
+			t.Errorf("stored the (%v) parameters", provider.params)
+		}
+	})
+
+	t.Run("with defined params", func(t *testing.T) {
+		params := NewServiceProviderParams()
+		if provider := NewServiceProvider(params); provider == nil {
+			t.Error("didn't returned a valid reference")
+		} else if params != provider.params {
+			t.Errorf("stored the (%v) parameters", provider.params)
+		}
+	})
+}
+
+func Test_ServiceProvider_Provides(t *testing.T) {
+	t.Run("retrieve the provided entries", func(t *testing.T) {
+		expected := []string{ContainerServiceRegistryID, ContainerServiceLoaderID}
+
+		if provides := NewServiceProvider(nil).Provides(); !reflect.DeepEqual(provides, expected) {
+			t.Errorf("returned the (%v) list", provides)
+		}
+	})
+}
+
+func Test_ServiceProvider_Requires(t *testing.T) {
+	t.Run("retrieve the required entries", func(t *testing.T) {
+		expected := []string{ContainerConfigID}
+
+		if requires := NewServiceProvider(nil).Requires(); !reflect.DeepEqual(requires, expected) {
+			t.Errorf("returned the (%v) list", requires)
+		}
+	})
+}
+
+func Test_ServiceProvider_Register(t *testing.T) {
+	t.Run("nil container", func(t *testing.T) {
+		provider := NewServiceProvider(nil)
+		if err := provider.Register(nil); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'container' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("register components", func(t *testing.T) {
+		container := NewAppContainer()
+		provider := NewServiceProvider(nil)
+
+		if err := provider.Register(container); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !container.Has(ContainerServiceRegistryID) {
+			t.Error("didn't registered the service registry", err)
+		} else if !container.Has(ContainerServiceLoaderID) {
+			t.Error("didn't registered the service loader", err)
+		}
+	})
+
+	t.Run("retrieving service registry", func(t *testing.T) {
+		container := NewAppContainer()
+		_ = NewServiceProvider(nil).Register(container)
+
+		if registry, err := container.Get(ContainerServiceRegistryID); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if registry == nil {
+			t.Error("didn't returned a valid reference")
+		} else {
+			switch registry.(type) {
+			case *ServiceRegistry:
+			default:
+				t.Error("didn't returned a registry reference")
+			}
+		}
+	})
+
+	t.Run("error retrieving registry on retrieving service loader", func(t *testing.T) {
+		container := NewAppContainer()
+		_ = NewServiceProvider(nil).Register(container)
+
+		_ = container.Add(ContainerServiceRegistryID, func(*AppContainer) (interface{}, error) {
+			return nil, fmt.Errorf("error")
+		})
+
+		if loader, err := container.Get(ContainerServiceLoaderID); loader != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error", err)
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("invalid registry on retrieving service loader", func(t *testing.T) {
+		container := NewAppContainer()
+		_ = NewServiceProvider(nil).Register(container)
+
+		_ = container.Add(ContainerServiceRegistryID, func(*AppContainer) (interface{}, error) {
+			return "string", nil
+		})
+
+		if loader, err := container.Get(ContainerServiceLoaderID); loader != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error", err)
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.Contains(err.Error(), "interface conversion") {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("retrieving service loader", func(t *testing.T) {
+		container := NewAppContainer()
+		_ = NewServiceProvider(nil).Register(container)
+
+		if loader, err := container.Get(ContainerServiceLoaderID); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if loader == nil {
+			t.Error("didn't returned a valid reference")
+		} else {
+			switch loader.(type) {
+			case *ServiceLoader:
+			default:
+				t.Error("didn't returned a loader reference")
+			}
+		}
+	})
+}
+
+func Test_ServiceProvider_Boot(t *testing.T) {
+	t.Run("error retrieving loader", func(t *testing.T) {
+		container := NewAppContainer()
+		provider := NewServiceProvider(nil)
+		_ = provider.Register(container)
+
+		_ = container.Add(ContainerServiceLoaderID, func(*AppContainer) (interface{}, error) {
+			return nil, fmt.Errorf("error")
+		})
+
+		if err := provider.Boot(container); err == nil {
+			t.Error("didn't returned the expected error", err)
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("invalid loader", func(t *testing.T) {
+		container := NewAppContainer()
+		_ = NewFileSystemProvider(nil).Register(container)
+		_ = NewConfigProvider(nil).Register(container)
+		provider := NewServiceProvider(nil)
+		_ = provider.Register(container)
+
+		_ = container.Add(ContainerServiceLoaderID, func(*AppContainer) (interface{}, error) {
+			return "string", nil
+		})
+
+		if err := provider.Boot(container); err == nil {
+			t.Error("didn't returned the expected error", err)
+		} else if strings.Index(err.Error(), "interface conversion") != 0 {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error retrieving config", func(t *testing.T) {
+		container := NewAppContainer()
+		provider := NewServiceProvider(nil)
+		_ = provider.Register(container)
+
+		_ = container.Add(ContainerConfigID, func(*AppContainer) (interface{}, error) {
+			return nil, fmt.Errorf("error")
+		})
+
+		if err := provider.Boot(container); err == nil {
+			t.Error("didn't returned the expected error", err)
+		} else if !errors.Is(err, ErrFactoryFailed) || !strings.HasSuffix(err.Error(), " : error") {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		container := NewAppContainer()
+		provider := NewServiceProvider(nil)
+		_ = provider.Register(container)
+
+		_ = container.Add(ContainerConfigID, func(*AppContainer) (interface{}, error) {
+			return "string", nil
+		})
+
+		if err := provider.Boot(container); err == nil {
+			t.Error("didn't returned the expected error", err)
+		} else if strings.Index(err.Error(), "interface conversion") != 0 {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("register the configured services", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		container := NewAppContainer()
+		defer container.Close()
+
+		provider := NewServiceProvider(nil)
+		_ = provider.Register(container)
+
+		_ = container.Add(ContainerConfigID, func(*AppContainer) (interface{}, error) {
+			return serviceLoaderConfig(ctrl, ConfigPartial{
+				"id":          "id",
+				"constructor": "target",
+				"arguments":   []interface{}{ConfigPartial{"value": "value"}},
+			}), nil
+		})
+
+		registry, _ := container.Get(ContainerServiceRegistryID)
+		_ = registry.(*ServiceRegistry).Register("target", func(value string) string { return value })
+
+		if err := provider.Boot(container); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry, err := container.Get("id"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if entry != "value" {
+			t.Errorf("returned the (%v) entry", entry)
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package servlet
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ServiceRegistry defines a storage of named constructor functions that
+// can be referenced by the service definitions of the configuration.
+type ServiceRegistry struct {
+	mutex        sync.Locker
+	constructors map[string]interface{}
+}
+
+// NewServiceRegistry will instantiate a new empty service constructor
+// registry.
+func NewServiceRegistry() *ServiceRegistry {
+	return &ServiceRegistry{
+		mutex:        &sync.Mutex{},
+		constructors: map[string]interface{}{},
+	}
+}
+
+// Register will store a constructor function with the given name.
+// The constructor must be a non-variadic function that returns the
+// created object, optionally followed by an error. If a constructor was
+// previously registered with the same name, it will be replaced.
+func (r *ServiceRegistry) Register(name string, constructor interface{}) error {
+	if r == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	if constructor == nil {
+		return fmt.Errorf("invalid nil 'constructor' argument")
+	}
+
+	if err := checkConstructor(constructor); err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.constructors[name] = constructor
+	return nil
+}
+
+// Has will check if a constructor was registered with the given name.
+func (r *ServiceRegistry) Has(name string) bool {
+	if r == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	_, ok := r.constructors[name]
+	return ok
+}
+
+// Get will retrieve the constructor registered with the given name.
+func (r *ServiceRegistry) Get(name string) (interface{}, error) {
+	if r == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	constructor, ok := r.constructors[name]
+	if !ok {
+		return nil, fmt.Errorf("constructor '%s' not registered", name)
+	}
+	return constructor, nil
+}
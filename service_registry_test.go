@@ -0,0 +1,149 @@
+package servlet
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_NewServiceRegistry(t *testing.T) {
+	t.Run("new registry", func(t *testing.T) {
+		if registry := NewServiceRegistry(); registry == nil {
+			t.Error("didn't returned a valid reference")
+		} else if len(registry.constructors) != 0 {
+			t.Errorf("stored the (%v) constructors", registry.constructors)
+		}
+	})
+}
+
+func Test_ServiceRegistry_Register(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			} else {
+				switch e := r.(type) {
+				case error:
+					if e.Error() != "nil pointer receiver" {
+						t.Errorf("panic with the (%v) error", e)
+					}
+				default:
+					t.Error("didn't panic with an error")
+				}
+			}
+		}()
+
+		var registry *ServiceRegistry
+		_ = registry.Register("name", func() string { return "value" })
+	})
+
+	t.Run("invalid constructor", func(t *testing.T) {
+		scenarios := []struct {
+			constructor interface{}
+			expected    string
+		}{
+			{ // test nil constructor
+				constructor: nil,
+				expected:    "invalid nil 'constructor' argument",
+			},
+			{ // test non-function constructor
+				constructor: "string",
+				expected:    "invalid non-function 'string' constructor",
+			},
+			{ // test variadic constructor
+				constructor: func(...int) string { return "" },
+				expected:    "invalid variadic 'func(...int) string' constructor",
+			},
+			{ // test constructor without return values
+				constructor: func() {},
+				expected:    "invalid 'func()' constructor return signature",
+			},
+			{ // test constructor with a non-error second return value
+				constructor: func() (string, string) { return "", "" },
+				expected:    "invalid 'func() (string, string)' constructor return signature",
+			},
+		}
+
+		for _, scn := range scenarios {
+			registry := NewServiceRegistry()
+			if err := registry.Register("name", scn.constructor); err == nil {
+				t.Error("didn't returned the expected error")
+			} else if err.Error() != scn.expected {
+				t.Errorf("returned the (%v) error", err)
+			} else if registry.Has("name") {
+				t.Error("stored the invalid constructor")
+			}
+		}
+	})
+
+	t.Run("register the constructor", func(t *testing.T) {
+		constructor := func(value string) (string, error) { return value, nil }
+
+		registry := NewServiceRegistry()
+		if err := registry.Register("name", constructor); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !registry.Has("name") {
+			t.Error("didn't stored the constructor")
+		} else if check, _ := registry.Get("name"); reflect.ValueOf(check).Pointer() != reflect.ValueOf(constructor).Pointer() {
+			t.Error("didn't stored the given constructor")
+		}
+	})
+}
+
+func Test_ServiceRegistry_Has(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var registry *ServiceRegistry
+		_ = registry.Has("name")
+	})
+
+	t.Run("check the constructor existence", func(t *testing.T) {
+		registry := NewServiceRegistry()
+		_ = registry.Register("name", func() string { return "value" })
+
+		if !registry.Has("name") {
+			t.Error("didn't found the registered constructor")
+		} else if registry.Has("other") {
+			t.Error("found a non-registered constructor")
+		}
+	})
+}
+
+func Test_ServiceRegistry_Get(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var registry *ServiceRegistry
+		_, _ = registry.Get("name")
+	})
+
+	t.Run("non-registered constructor", func(t *testing.T) {
+		registry := NewServiceRegistry()
+		if constructor, err := registry.Get("name"); constructor != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "constructor 'name' not registered" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("retrieve the constructor", func(t *testing.T) {
+		registry := NewServiceRegistry()
+		_ = registry.Register("name", func() string { return "value" })
+
+		if constructor, err := registry.Get("name"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if value := constructor.(func() string)(); value != "value" {
+			t.Errorf("returned the (%v) constructor result", value)
+		}
+	})
+}
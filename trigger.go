@@ -1,7 +1,9 @@
 package servlet
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"time"
 )
 
@@ -11,13 +13,31 @@ import (
 
 // Trigger defines the base trigger instance functionality.
 type Trigger struct {
-	timer       time.Duration
-	callback    TriggerCallback
-	isStopped   bool
-	channelStop chan bool
+	mutex        sync.Locker
+	timer        time.Duration
+	callback     TriggerCallback
+	errorHandler TriggerErrorHandler
+	failure      TriggerFailurePolicy
+	next         time.Time
+	ctx          context.Context
+	cancel       context.CancelFunc
+	done         chan struct{}
 }
 
-// Close will retrieve the time period associated to the trigger.
+func newTrigger(timer time.Duration, callback TriggerCallback) Trigger {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return Trigger{
+		mutex:    &sync.Mutex{},
+		timer:    timer,
+		callback: callback,
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+}
+
+// Close will stop the trigger execution.
 func (t *Trigger) Close() {
 	if t == nil {
 		panic(fmt.Errorf("nil pointer receiver"))
@@ -27,23 +47,130 @@ func (t *Trigger) Close() {
 }
 
 // Timer will retrieve the time period associated to the trigger.
-func (t Trigger) Timer() time.Duration {
+func (t *Trigger) Timer() time.Duration {
+	if t == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
 	return t.timer
 }
 
-// IsStopped check if the trigger is stopped.
-func (t Trigger) IsStopped() bool {
-	return t.isStopped
+// IsStopped check if the trigger is stopped, either by a stop request or
+// by the termination of the trigger execution.
+func (t *Trigger) IsStopped() bool {
+	if t == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	select {
+	case <-t.done:
+		return true
+	default:
+		return t.ctx.Err() != nil
+	}
 }
 
-// Stop signals the trigger to stop execution.
+// NextRun will retrieve the time of the next scheduled callback execution.
+// A zero time is returned if the trigger is stopped or if no execution is
+// currently scheduled, as while a fixed delay execution is running.
+func (t *Trigger) NextRun() time.Time {
+	if t == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	if t.IsStopped() {
+		return time.Time{}
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.next
+}
+
+// Stop signals the trigger to stop execution. The context given to the
+// callback is cancelled, so any running execution can be interrupted.
 func (t *Trigger) Stop() {
 	if t == nil {
 		panic(fmt.Errorf("nil pointer receiver"))
 	}
 
-	if !t.isStopped {
-		t.isStopped = true
-		t.channelStop <- true
+	t.cancel()
+}
+
+// Done will retrieve a channel that is closed when the trigger execution
+// goroutine terminates.
+func (t *Trigger) Done() <-chan struct{} {
+	if t == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
 	}
+
+	return t.done
+}
+
+// Wait will block until the trigger execution goroutine terminates.
+func (t *Trigger) Wait() {
+	if t == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	<-t.done
+}
+
+// SetErrorHandler will define the handler called with the errors returned
+// by the trigger callback.
+func (t *Trigger) SetErrorHandler(handler TriggerErrorHandler) {
+	if t == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.errorHandler = handler
+}
+
+// SetFailurePolicy will define how a recurring trigger reacts to the
+// errors returned by the trigger callback. By default, the trigger is
+// stopped on the first error. As the trigger is already running, the policy
+// is not applied to an execution that fails before this call, so the
+// trigger constructors that receive the failure policy should be used to
+// define the policy of the trigger from its creation.
+func (t *Trigger) SetFailurePolicy(policy TriggerFailurePolicy) {
+	if t == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.failure = policy
+}
+
+func (t *Trigger) execute() error {
+	err := t.callback(t.ctx)
+	if err != nil {
+		t.mutex.Lock()
+		handler := t.errorHandler
+		t.mutex.Unlock()
+
+		if handler != nil {
+			handler(err)
+		}
+	}
+	return err
+}
+
+func (t *Trigger) fail(failures int) (time.Duration, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.failure.next(failures)
+}
+
+func (t *Trigger) plan(at time.Time) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.next = at
 }
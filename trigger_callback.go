@@ -1,4 +1,7 @@
 package servlet
 
-// TriggerCallback used as a trigger execution process.
-type TriggerCallback func() error
+import "context"
+
+// TriggerCallback used as a trigger execution process. The given context
+// is cancelled when the trigger is stopped.
+type TriggerCallback func(ctx context.Context) error
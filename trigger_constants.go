@@ -0,0 +1,12 @@
+package servlet
+
+const (
+	// ContainerTriggerSchedulerID defines the id to be used as the default
+	// of a trigger scheduler instance in the application container.
+	ContainerTriggerSchedulerID = "servlet.trigger.scheduler"
+
+	// EnvContainerTriggerSchedulerID defines the name of the environment
+	// variable to be checked for a overriding value for the application
+	// container trigger scheduler id.
+	EnvContainerTriggerSchedulerID = "SERVLET_CONTAINER_TRIGGER_SCHEDULER_ID"
+)
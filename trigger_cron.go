@@ -0,0 +1,107 @@
+package servlet
+
+import (
+	"fmt"
+	"time"
+)
+
+// TriggerCron defines a trigger instance used to execute a process on the
+// activation times defined by a cron expression.
+type TriggerCron struct {
+	Trigger
+	expression *TriggerCronExpression
+}
+
+// NewTriggerCron instantiate a new trigger that will execute a callback
+// method on every activation time of the given cron expression, evaluated
+// in the given location (local time zone if nil). As the recurring trigger,
+// the callback errors are handled as defined by the trigger failure policy,
+// where the backoff delays are used to retry the callback execution prior
+// the next scheduled activation.
+func NewTriggerCron(expression string, location *time.Location, callback TriggerCallback) (*TriggerCron, error) {
+	return NewTriggerCronWithPolicy(expression, location, TriggerFailurePolicy{}, callback)
+}
+
+// NewTriggerCronWithPolicy instantiate a new cron trigger with the given
+// failure policy, that is already applied to the first callback execution.
+func NewTriggerCronWithPolicy(expression string, location *time.Location, failure TriggerFailurePolicy, callback TriggerCallback) (*TriggerCron, error) {
+	if callback == nil {
+		return nil, fmt.Errorf("invalid nil 'callback' argument")
+	}
+
+	e, err := NewTriggerCronExpression(expression, location)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &TriggerCron{
+		Trigger:    newTrigger(0, callback),
+		expression: e,
+	}
+	t.failure = failure
+
+	go func() {
+		defer close(t.done)
+
+		failures := 0
+		var retry time.Duration
+		for {
+			next := t.expression.Next(time.Now())
+			if retry > 0 {
+				if at := time.Now().Add(retry); next.IsZero() || at.Before(next) {
+					next = at
+				}
+			}
+			if next.IsZero() {
+				t.cancel()
+				return
+			}
+
+			t.plan(next)
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-timer.C:
+				t.plan(time.Time{})
+				if t.ctx.Err() != nil {
+					return
+				}
+
+				retry = 0
+				if err := t.execute(); err == nil {
+					failures = 0
+				} else {
+					failures++
+					delay, ok := t.fail(failures)
+					if !ok {
+						t.cancel()
+						return
+					}
+					retry = delay
+				}
+			case <-t.ctx.Done():
+				timer.Stop()
+				return
+			}
+		}
+	}()
+
+	return t, nil
+}
+
+// Expression will retrieve the cron expression associated to the trigger.
+func (t *TriggerCron) Expression() *TriggerCronExpression {
+	if t == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	return t.expression
+}
+
+// Next will retrieve the next activation time of the trigger.
+func (t *TriggerCron) Next() time.Time {
+	if t == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	return t.expression.Next(time.Now())
+}
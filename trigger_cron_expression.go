@@ -0,0 +1,263 @@
+package servlet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type triggerCronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	triggerCronSecond = triggerCronField{name: "second", min: 0, max: 59}
+	triggerCronMinute = triggerCronField{name: "minute", min: 0, max: 59}
+	triggerCronHour   = triggerCronField{name: "hour", min: 0, max: 23}
+	triggerCronDay    = triggerCronField{name: "day of month", min: 1, max: 31}
+	triggerCronMonth  = triggerCronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	triggerCronWeekday = triggerCronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// TriggerCronMacros defines a relation between the supported cron macros
+// and the equivalent 6 field cron expressions.
+var TriggerCronMacros = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// TriggerCronExpression defines a parsed cron expression used to calculate
+// the activation times of a cron trigger.
+type TriggerCronExpression struct {
+	expression string
+	location   *time.Location
+	seconds    uint64
+	minutes    uint64
+	hours      uint64
+	days       uint64
+	months     uint64
+	weekdays   uint64
+	anyDay     bool
+	anyWeekday bool
+}
+
+// NewTriggerCronExpression will parse a cron expression. The expression can
+// be defined with 5 fields (minute, hour, day of month, month and day of
+// week) or 6 fields (with a leading second field), where each field can be
+// a wildcard (* or ?), a value, a name (jan-dec, sun-sat), a range (1-5),
+// a step (*/15, 0-30/5) or a comma separated list of them. The @yearly,
+// @annually, @monthly, @weekly, @daily, @midnight and @hourly macros are
+// also supported.
+// The expression is evaluated in the given location, or in the local time
+// zone if no location is given. A leading CRON_TZ=<zone> or TZ=<zone>
+// prefix can be used to define the location in the expression itself.
+// If both the day of month and day of week fields are restricted, a day
+// matching any of them is activated, unless one of them starts with a
+// wildcard, in which case the day must match both.
+// An expression that is never activated (ex: 0 0 30 2 *) is rejected.
+func NewTriggerCronExpression(expression string, location *time.Location) (*TriggerCronExpression, error) {
+	if location == nil {
+		location = time.Local
+	}
+
+	e := &TriggerCronExpression{
+		expression: expression,
+		location:   location,
+	}
+
+	spec := strings.TrimSpace(expression)
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		parts := strings.SplitN(spec, " ", 2)
+		zone := parts[0][strings.Index(parts[0], "=")+1:]
+
+		var err error
+		if e.location, err = time.LoadLocation(zone); err != nil {
+			return nil, fmt.Errorf("invalid '%s' cron time zone", zone)
+		}
+
+		spec = ""
+		if len(parts) == 2 {
+			spec = strings.TrimSpace(parts[1])
+		}
+	}
+
+	if strings.HasPrefix(spec, "@") {
+		macro, ok := TriggerCronMacros[strings.ToLower(spec)]
+		if !ok {
+			return nil, fmt.Errorf("invalid '%s' cron macro", spec)
+		}
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("invalid '%s' cron expression", expression)
+	}
+
+	var err error
+	if e.seconds, err = triggerCronSecond.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if e.minutes, err = triggerCronMinute.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if e.hours, err = triggerCronHour.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if e.days, err = triggerCronDay.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if e.months, err = triggerCronMonth.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	if e.weekdays, err = triggerCronWeekday.parse(fields[5]); err != nil {
+		return nil, err
+	}
+
+	// sunday can be defined as 0 or 7
+	if e.weekdays&(1<<7) != 0 {
+		e.weekdays |= 1
+	}
+
+	// as in the standard cron, a day field starting with a wildcard (like
+	// */2) is not a restriction, so the other day field must also match
+	e.anyDay = strings.HasPrefix(fields[3], "*") || fields[3] == "?"
+	e.anyWeekday = strings.HasPrefix(fields[5], "*") || fields[5] == "?"
+
+	if e.Next(time.Now()).IsZero() {
+		return nil, fmt.Errorf("invalid '%s' cron expression without activation times", expression)
+	}
+
+	return e, nil
+}
+
+// String will retrieve the textual cron expression.
+func (e TriggerCronExpression) String() string {
+	return e.expression
+}
+
+// Location will retrieve the location used to evaluate the expression.
+func (e TriggerCronExpression) Location() *time.Location {
+	return e.location
+}
+
+// Next will retrieve the first activation time after the given time. A zero
+// time is returned if no activation time exists in the following five years.
+func (e TriggerCronExpression) Next(from time.Time) time.Time {
+	t := from.In(e.location)
+	t = t.Add(time.Second - time.Duration(t.Nanosecond()))
+
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case e.months&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, e.location)
+		case !e.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, e.location)
+		case e.hours&(1<<uint(t.Hour())) == 0:
+			t = t.Add(time.Hour - time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second)
+		case e.minutes&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute - time.Duration(t.Second())*time.Second)
+		case e.seconds&(1<<uint(t.Second())) == 0:
+			t = t.Add(time.Second)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (e TriggerCronExpression) matchDay(t time.Time) bool {
+	day := e.days&(1<<uint(t.Day())) != 0
+	weekday := e.weekdays&(1<<uint(t.Weekday())) != 0
+
+	// when both day fields are restricted, matching any of them is enough
+	if !e.anyDay && !e.anyWeekday {
+		return day || weekday
+	}
+	return day && weekday
+}
+
+func (f triggerCronField) parse(field string) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		from, to, step, err := f.parseItem(item)
+		if err != nil {
+			return 0, fmt.Errorf("invalid '%s' cron %s field", field, f.name)
+		}
+
+		for value := from; value <= to; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+	return bits, nil
+}
+
+func (f triggerCronField) parseItem(item string) (from, to, step int, err error) {
+	step = 1
+	stepped := false
+	if i := strings.Index(item, "/"); i >= 0 {
+		stepped = true
+		if step, err = strconv.Atoi(item[i+1:]); err != nil || step <= 0 {
+			return 0, 0, 0, fmt.Errorf("invalid step")
+		}
+		item = item[:i]
+	}
+
+	switch {
+	case item == "*" || item == "?":
+		return f.min, f.max, step, nil
+	case strings.Contains(item, "-"):
+		bounds := strings.SplitN(item, "-", 2)
+		if from, err = f.value(bounds[0]); err != nil {
+			return 0, 0, 0, err
+		}
+		if to, err = f.value(bounds[1]); err != nil {
+			return 0, 0, 0, err
+		}
+		if from > to {
+			return 0, 0, 0, fmt.Errorf("invalid range")
+		}
+		return from, to, step, nil
+	}
+
+	if from, err = f.value(item); err != nil {
+		return 0, 0, 0, err
+	}
+
+	// a single value with a step defines a range up to the field maximum
+	if stepped {
+		return from, f.max, step, nil
+	}
+	return from, from, step, nil
+}
+
+func (f triggerCronField) value(text string) (int, error) {
+	if value, ok := f.names[strings.ToLower(text)]; ok {
+		return value, nil
+	}
+
+	value, err := strconv.Atoi(text)
+	if err != nil || value < f.min || value > f.max {
+		return 0, fmt.Errorf("invalid value")
+	}
+	return value, nil
+}
@@ -0,0 +1,224 @@
+package servlet
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_NewTriggerCronExpression(t *testing.T) {
+	t.Run("invalid expression", func(t *testing.T) {
+		scenarios := []struct {
+			expression string
+			expected   string
+		}{
+			{ // test empty expression
+				expression: "",
+				expected:   "invalid '' cron expression",
+			},
+			{ // test too few fields
+				expression: "* * * *",
+				expected:   "invalid '* * * *' cron expression",
+			},
+			{ // test too many fields
+				expression: "* * * * * * *",
+				expected:   "invalid '* * * * * * *' cron expression",
+			},
+			{ // test unknown macro
+				expression: "@never",
+				expected:   "invalid '@never' cron macro",
+			},
+			{ // test unknown time zone
+				expression: "CRON_TZ=Nowhere/City * * * * *",
+				expected:   "invalid 'Nowhere/City' cron time zone",
+			},
+			{ // test out of range second
+				expression: "60 * * * * *",
+				expected:   "invalid '60' cron second field",
+			},
+			{ // test out of range minute
+				expression: "60 * * * *",
+				expected:   "invalid '60' cron minute field",
+			},
+			{ // test out of range hour
+				expression: "* 24 * * *",
+				expected:   "invalid '24' cron hour field",
+			},
+			{ // test out of range day of month
+				expression: "* * 0 * *",
+				expected:   "invalid '0' cron day of month field",
+			},
+			{ // test unknown month name
+				expression: "* * * foo *",
+				expected:   "invalid 'foo' cron month field",
+			},
+			{ // test out of range day of week
+				expression: "* * * * 8",
+				expected:   "invalid '8' cron day of week field",
+			},
+			{ // test inverted range
+				expression: "10-5 * * * *",
+				expected:   "invalid '10-5' cron minute field",
+			},
+			{ // test invalid step
+				expression: "*/0 * * * *",
+				expected:   "invalid '*/0' cron minute field",
+			},
+			{ // test invalid list item
+				expression: "1,,2 * * * *",
+				expected:   "invalid '1,,2' cron minute field",
+			},
+			{ // test non-existing date
+				expression: "0 0 30 2 *",
+				expected:   "invalid '0 0 30 2 *' cron expression without activation times",
+			},
+		}
+
+		for _, scn := range scenarios {
+			if e, err := NewTriggerCronExpression(scn.expression, nil); e != nil {
+				t.Error("returned a valid reference")
+			} else if err == nil {
+				t.Error("didn't returned the expected error")
+			} else if err.Error() != scn.expected {
+				t.Errorf("returned the (%v) error", err)
+			}
+		}
+	})
+
+	t.Run("default to the local time zone", func(t *testing.T) {
+		if e, err := NewTriggerCronExpression("* * * * *", nil); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if e.Location() != time.Local {
+			t.Errorf("stored the (%v) location", e.Location())
+		} else if e.String() != "* * * * *" {
+			t.Errorf("stored the (%v) expression", e.String())
+		}
+	})
+
+	t.Run("time zone defined in the expression", func(t *testing.T) {
+		if e, err := NewTriggerCronExpression("CRON_TZ=America/New_York @daily", time.UTC); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if e.Location().String() != "America/New_York" {
+			t.Errorf("stored the (%v) location", e.Location())
+		}
+	})
+}
+
+func Test_TriggerCronExpression_Next(t *testing.T) {
+	t.Run("calculate the next activation time", func(t *testing.T) {
+		// 2021-03-10 is a wednesday
+		from := time.Date(2021, 3, 10, 10, 20, 30, 500, time.UTC)
+
+		scenarios := []struct {
+			expression string
+			expected   time.Time
+		}{
+			{ // test every minute
+				expression: "* * * * *",
+				expected:   time.Date(2021, 3, 10, 10, 21, 0, 0, time.UTC),
+			},
+			{ // test every second
+				expression: "* * * * * *",
+				expected:   time.Date(2021, 3, 10, 10, 20, 31, 0, time.UTC),
+			},
+			{ // test specific minute and hour
+				expression: "30 2 * * *",
+				expected:   time.Date(2021, 3, 11, 2, 30, 0, 0, time.UTC),
+			},
+			{ // test minute step
+				expression: "*/15 * * * *",
+				expected:   time.Date(2021, 3, 10, 10, 30, 0, 0, time.UTC),
+			},
+			{ // test range with step
+				expression: "0 9-17/4 * * *",
+				expected:   time.Date(2021, 3, 10, 13, 0, 0, 0, time.UTC),
+			},
+			{ // test value with step
+				expression: "0 22/1 * * *",
+				expected:   time.Date(2021, 3, 10, 22, 0, 0, 0, time.UTC),
+			},
+			{ // test list
+				expression: "0 0 5,20 * *",
+				expected:   time.Date(2021, 3, 20, 0, 0, 0, 0, time.UTC),
+			},
+			{ // test month and weekday names
+				expression: "0 0 * JUN-aug mon",
+				expected:   time.Date(2021, 6, 7, 0, 0, 0, 0, time.UTC),
+			},
+			{ // test sunday as 7
+				expression: "0 0 * * 7",
+				expected:   time.Date(2021, 3, 14, 0, 0, 0, 0, time.UTC),
+			},
+			{ // test restricted day of month or day of week
+				expression: "0 0 1 * fri",
+				expected:   time.Date(2021, 3, 12, 0, 0, 0, 0, time.UTC),
+			},
+			{ // test restricted day of month with wildcard day of week
+				expression: "0 0 1 * ?",
+				expected:   time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+			},
+			{ // test stepped wildcard day of month with restricted day of week
+				expression: "0 0 */2 * 1",
+				expected:   time.Date(2021, 3, 15, 0, 0, 0, 0, time.UTC),
+			},
+			{ // test restricted day of month with stepped wildcard day of week
+				expression: "0 0 20 * */7",
+				expected:   time.Date(2021, 6, 20, 0, 0, 0, 0, time.UTC),
+			},
+			{ // test leap day
+				expression: "0 0 29 2 *",
+				expected:   time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+			{ // test yearly macro
+				expression: "@yearly",
+				expected:   time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			{ // test monthly macro
+				expression: "@monthly",
+				expected:   time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+			},
+			{ // test weekly macro
+				expression: "@weekly",
+				expected:   time.Date(2021, 3, 14, 0, 0, 0, 0, time.UTC),
+			},
+			{ // test daily macro
+				expression: "@daily",
+				expected:   time.Date(2021, 3, 11, 0, 0, 0, 0, time.UTC),
+			},
+			{ // test hourly macro
+				expression: "@HOURLY",
+				expected:   time.Date(2021, 3, 10, 11, 0, 0, 0, time.UTC),
+			},
+		}
+
+		for _, scn := range scenarios {
+			e, err := NewTriggerCronExpression(scn.expression, time.UTC)
+			if err != nil {
+				t.Errorf("returned the (%v) error", err)
+			} else if next := e.Next(from); !next.Equal(scn.expected) {
+				t.Errorf("(%s) returned the (%v) activation time", scn.expression, next)
+			}
+		}
+	})
+
+	t.Run("calculate the activation time in the expression location", func(t *testing.T) {
+		location, _ := time.LoadLocation("America/New_York")
+		from := time.Date(2021, 3, 10, 10, 0, 0, 0, time.UTC)
+		expected := time.Date(2021, 3, 11, 0, 0, 0, 0, location)
+
+		e, _ := NewTriggerCronExpression("@daily", location)
+		if next := e.Next(from); !next.Equal(expected) {
+			t.Errorf("returned the (%v) activation time", next)
+		}
+	})
+
+	t.Run("skip the non-existing daylight saving time", func(t *testing.T) {
+		location, _ := time.LoadLocation("America/New_York")
+		from := time.Date(2021, 3, 14, 0, 0, 0, 0, location)
+		expected := time.Date(2021, 3, 15, 2, 30, 0, 0, location)
+
+		e, _ := NewTriggerCronExpression("30 2 * * *", location)
+		if next := e.Next(from); !next.Equal(expected) {
+			t.Errorf("returned the (%v) activation time", next)
+		}
+	})
+}
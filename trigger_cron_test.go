@@ -0,0 +1,184 @@
+package servlet
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_NewTriggerCron(t *testing.T) {
+	t.Run("nil callback", func(t *testing.T) {
+		if trigger, err := NewTriggerCron("* * * * *", nil, nil); trigger != nil {
+			defer trigger.Close()
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'callback' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("invalid expression", func(t *testing.T) {
+		if trigger, err := NewTriggerCron("* * *", nil, func(context.Context) error { return nil }); trigger != nil {
+			defer trigger.Close()
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid '* * *' cron expression" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("never activated expression", func(t *testing.T) {
+		if trigger, err := NewTriggerCron("0 0 31 4 *", nil, func(context.Context) error { return nil }); trigger != nil {
+			defer trigger.Close()
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid '0 0 31 4 *' cron expression without activation times" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("new cron trigger", func(t *testing.T) {
+		if trigger, err := NewTriggerCron("* * * * *", time.UTC, func(context.Context) error {
+			return nil
+		}); trigger == nil {
+			t.Error("didn't returned a valid reference")
+		} else {
+			defer trigger.Close()
+			if err != nil {
+				t.Errorf("returned the (%v) error", err)
+			} else if trigger.Expression().String() != "* * * * *" {
+				t.Errorf("stored the (%v) expression", trigger.Expression())
+			} else if trigger.Expression().Location() != time.UTC {
+				t.Errorf("stored the (%v) location", trigger.Expression().Location())
+			}
+		}
+	})
+}
+
+func Test_NewTriggerCronWithPolicy(t *testing.T) {
+	t.Run("nil callback", func(t *testing.T) {
+		if trigger, err := NewTriggerCronWithPolicy("* * * * *", nil, TriggerFailurePolicy{}, nil); trigger != nil {
+			defer trigger.Close()
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'callback' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("apply the failure policy to the first execution", func(t *testing.T) {
+		check := int32(0)
+
+		policy := TriggerFailurePolicy{Action: TriggerFailureContinue}
+		trigger, _ := NewTriggerCronWithPolicy("* * * * * *", nil, policy, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return fmt.Errorf("__dummy_error__")
+		})
+		defer trigger.Close()
+
+		time.Sleep(2100 * time.Millisecond)
+
+		if trigger.IsStopped() {
+			t.Error("stopped the trigger on the first callback error")
+		} else if c := atomic.LoadInt32(&check); c < 2 {
+			t.Errorf("executed the callback (%d) times", c)
+		}
+	})
+}
+
+func Test_TriggerCron_Close(t *testing.T) {
+	t.Run("is the same as stopping it", func(t *testing.T) {
+		check := false
+		trigger, _ := NewTriggerCron("* * * * * *", nil, func(context.Context) error {
+			check = true
+			return nil
+		})
+		trigger.Close()
+
+		time.Sleep(1100 * time.Millisecond)
+		if check {
+			t.Error("didn't stop the trigger to be executed")
+		}
+	})
+}
+
+func Test_TriggerCron_IsStopped(t *testing.T) {
+	t.Run("return false if called after creation", func(t *testing.T) {
+		trigger, _ := NewTriggerCron("* * * * *", nil, func(context.Context) error {
+			return nil
+		})
+		defer trigger.Close()
+
+		if trigger.IsStopped() {
+			t.Error("returned true")
+		}
+	})
+
+	t.Run("return true after calling Stop method", func(t *testing.T) {
+		trigger, _ := NewTriggerCron("* * * * *", nil, func(context.Context) error {
+			return nil
+		})
+		defer trigger.Close()
+
+		trigger.Stop()
+
+		if !trigger.IsStopped() {
+			t.Error("returned false")
+		}
+	})
+}
+
+func Test_TriggerCron_Next(t *testing.T) {
+	t.Run("retrieve the next activation time", func(t *testing.T) {
+		trigger, _ := NewTriggerCron("@hourly", nil, func(context.Context) error {
+			return nil
+		})
+		defer trigger.Close()
+
+		now := time.Now()
+		if next := trigger.Next(); !next.After(now) || next.Sub(now) > time.Hour {
+			t.Errorf("returned the (%v) activation time", next)
+		} else if next.Minute() != 0 || next.Second() != 0 {
+			t.Errorf("returned the (%v) activation time", next)
+		}
+	})
+}
+
+func Test_TriggerCron(t *testing.T) {
+	t.Run("run trigger multiple times", func(t *testing.T) {
+		check := 0
+
+		trigger, _ := NewTriggerCron("* * * * * *", nil, func(context.Context) error { check = check + 1; return nil })
+		defer trigger.Close()
+
+		time.Sleep(2100 * time.Millisecond)
+		trigger.Stop()
+		trigger.Wait()
+
+		if check < 2 {
+			t.Error("didn't recurrently called the callback function")
+		}
+	})
+
+	t.Run("stop the trigger on callback error", func(t *testing.T) {
+		check := 0
+
+		trigger, _ := NewTriggerCron("* * * * * *", nil, func(context.Context) error { check = check + 1; return fmt.Errorf("__dummy_error__") })
+		defer trigger.Close()
+
+		time.Sleep(2100 * time.Millisecond)
+		trigger.Wait()
+
+		if check != 1 {
+			t.Error("didn't stop recursion calls after the first error")
+		} else if !trigger.IsStopped() {
+			t.Error("didn't flagged the trigger as stopped")
+		}
+	})
+}
@@ -0,0 +1,5 @@
+package servlet
+
+// TriggerErrorHandler used to process the errors returned by a trigger
+// execution process.
+type TriggerErrorHandler func(err error)
@@ -0,0 +1,6 @@
+package servlet
+
+// TriggerFactory defines a function used to instantiate a trigger that will
+// execute the given callback. A scheduler uses it to (re)start the trigger
+// of a job.
+type TriggerFactory func(callback TriggerCallback) (*Trigger, error)
@@ -0,0 +1,44 @@
+package servlet
+
+import "time"
+
+// TriggerFailureAction identifies a value type that describes how a
+// recurring trigger reacts to a callback error.
+type TriggerFailureAction int
+
+const (
+	// TriggerFailureStop defines that the trigger is stopped on the first
+	// callback error.
+	TriggerFailureStop TriggerFailureAction = iota
+	// TriggerFailureContinue defines that the trigger keeps executing the
+	// callback with the regular schedule after a callback error.
+	TriggerFailureContinue
+	// TriggerFailureBackoff defines that the callback is executed again
+	// after an exponentially increasing delay while it keeps failing,
+	// returning to the regular schedule after a successful execution.
+	TriggerFailureBackoff
+)
+
+// TriggerFailurePolicy defines the callback error handling policy of a
+// recurring trigger. If the MaxFailures value is defined, the trigger is
+// stopped after that number of consecutive failures. The Backoff and
+// MaxBackoff values define the exponential retry delay of the backoff
+// action, calculated in the same way as the runner restart delay.
+type TriggerFailurePolicy struct {
+	Action      TriggerFailureAction
+	MaxFailures int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+func (p TriggerFailurePolicy) next(failures int) (time.Duration, bool) {
+	switch {
+	case p.Action == TriggerFailureStop,
+		p.MaxFailures > 0 && failures >= p.MaxFailures:
+		return 0, false
+	case p.Action == TriggerFailureContinue:
+		return 0, true
+	}
+
+	return backoff(p.Backoff, p.MaxBackoff, failures), true
+}
@@ -0,0 +1,73 @@
+package servlet
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_TriggerFailurePolicy_next(t *testing.T) {
+	scenarios := []struct {
+		policy   TriggerFailurePolicy
+		failures int
+		delay    time.Duration
+		ok       bool
+	}{
+		{ // test stop action
+			policy:   TriggerFailurePolicy{Action: TriggerFailureStop},
+			failures: 1,
+			delay:    0,
+			ok:       false,
+		},
+		{ // test continue action
+			policy:   TriggerFailurePolicy{Action: TriggerFailureContinue},
+			failures: 10,
+			delay:    0,
+			ok:       true,
+		},
+		{ // test continue action bellow the max failures
+			policy:   TriggerFailurePolicy{Action: TriggerFailureContinue, MaxFailures: 3},
+			failures: 2,
+			delay:    0,
+			ok:       true,
+		},
+		{ // test continue action reaching the max failures
+			policy:   TriggerFailurePolicy{Action: TriggerFailureContinue, MaxFailures: 3},
+			failures: 3,
+			delay:    0,
+			ok:       false,
+		},
+		{ // test backoff action on first failure
+			policy:   TriggerFailurePolicy{Action: TriggerFailureBackoff, Backoff: time.Second},
+			failures: 1,
+			delay:    time.Second,
+			ok:       true,
+		},
+		{ // test backoff action doubling the delay
+			policy:   TriggerFailurePolicy{Action: TriggerFailureBackoff, Backoff: time.Second},
+			failures: 4,
+			delay:    8 * time.Second,
+			ok:       true,
+		},
+		{ // test backoff action capped by the max backoff
+			policy:   TriggerFailurePolicy{Action: TriggerFailureBackoff, Backoff: time.Second, MaxBackoff: 5 * time.Second},
+			failures: 10,
+			delay:    5 * time.Second,
+			ok:       true,
+		},
+		{ // test backoff action reaching the max failures
+			policy:   TriggerFailurePolicy{Action: TriggerFailureBackoff, Backoff: time.Second, MaxFailures: 2},
+			failures: 2,
+			delay:    0,
+			ok:       false,
+		},
+	}
+
+	for _, scn := range scenarios {
+		delay, ok := scn.policy.next(scn.failures)
+		if ok != scn.ok {
+			t.Errorf("(%v) returned (%v) continuation flag for (%d) failures", scn.policy, ok, scn.failures)
+		} else if delay != scn.delay {
+			t.Errorf("(%v) returned (%v) delay for (%d) failures", scn.policy, delay, scn.failures)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package servlet
+
+import (
+	"fmt"
+)
+
+// TriggerProvider defines the default trigger provider to be used on the
+// application initialization to register the trigger scheduler service.
+// The scheduler jobs are all stopped when the application container is
+// closed.
+type TriggerProvider struct {
+	params *TriggerProviderParams
+}
+
+// NewTriggerProvider will create a new trigger provider instance.
+func NewTriggerProvider(params *TriggerProviderParams) *TriggerProvider {
+	if params == nil {
+		params = NewTriggerProviderParams()
+	}
+
+	return &TriggerProvider{
+		params: params,
+	}
+}
+
+// Provides will retrieve the list of container entries registered
+// by the provider.
+func (p TriggerProvider) Provides() []string {
+	return []string{p.params.SchedulerID}
+}
+
+// Requires will retrieve the list of container entries needed by the
+// provider (none).
+func (TriggerProvider) Requires() []string {
+	return []string{}
+}
+
+// Register will register the trigger scheduler instance in the
+// application container.
+func (p TriggerProvider) Register(container *AppContainer) error {
+	if container == nil {
+		return fmt.Errorf("invalid nil 'container' argument")
+	}
+
+	return container.Add(p.params.SchedulerID, func(container *AppContainer) (interface{}, error) {
+		return NewTriggerScheduler(), nil
+	})
+}
+
+// Boot (no-op).
+func (TriggerProvider) Boot(_ *AppContainer) error {
+	return nil
+}
@@ -0,0 +1,49 @@
+package servlet
+
+import (
+	"fmt"
+	"os"
+)
+
+// TriggerProviderParams defines the trigger provider parameters storing
+// structure that will be needed when instantiating a new provider
+type TriggerProviderParams struct {
+	SchedulerID string
+}
+
+// NewTriggerProviderParams will instantiate a new trigger provider
+// parameters storing instance with the servlet default values.
+func NewTriggerProviderParams() *TriggerProviderParams {
+	params := &TriggerProviderParams{
+		SchedulerID: ContainerTriggerSchedulerID,
+	}
+
+	if env := os.Getenv(EnvContainerTriggerSchedulerID); env != "" {
+		params.SchedulerID = env
+	}
+
+	return params
+}
+
+// NewTriggerProviderParamsConfig will instantiate a new trigger provider
+// parameters storing instance with the servlet default values, overridden
+// by the values defined in the given configuration partial.
+func NewTriggerProviderParamsConfig(conf ConfigPartial) (params *TriggerProviderParams, err error) {
+	if conf == nil {
+		return nil, fmt.Errorf("invalid nil 'conf' argument")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			params, err = nil, panicError(r)
+		}
+	}()
+
+	params = NewTriggerProviderParams()
+
+	if conf.Has("scheduler_id") {
+		params.SchedulerID = conf.String("scheduler_id")
+	}
+
+	return params, nil
+}
@@ -0,0 +1,66 @@
+package servlet
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_NewTriggerProviderParams(t *testing.T) {
+	t.Run("no env override", func(t *testing.T) {
+		p := NewTriggerProviderParams()
+		if p.SchedulerID != ContainerTriggerSchedulerID {
+			t.Errorf("stored the '%s' trigger scheduler container id", p.SchedulerID)
+		}
+	})
+
+	t.Run("with env override", func(t *testing.T) {
+		value := "test_id"
+		_ = os.Setenv(EnvContainerTriggerSchedulerID, value)
+		defer func() { _ = os.Setenv(EnvContainerTriggerSchedulerID, "") }()
+
+		p := NewTriggerProviderParams()
+		if check := p.SchedulerID; check != value {
+			t.Errorf("stored the '%s' trigger scheduler container id", check)
+		}
+	})
+}
+
+func Test_NewTriggerProviderParamsConfig(t *testing.T) {
+	t.Run("nil config", func(t *testing.T) {
+		if params, err := NewTriggerProviderParamsConfig(nil); params != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'conf' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("invalid config value", func(t *testing.T) {
+		if params, err := NewTriggerProviderParamsConfig(ConfigPartial{"scheduler_id": 123}); params != nil {
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if !strings.HasPrefix(err.Error(), "interface conversion") {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("with the default values", func(t *testing.T) {
+		if params, err := NewTriggerProviderParamsConfig(ConfigPartial{}); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !reflect.DeepEqual(params, NewTriggerProviderParams()) {
+			t.Errorf("returned the (%v) params", params)
+		}
+	})
+
+	t.Run("with the config scheduler ID", func(t *testing.T) {
+		if params, err := NewTriggerProviderParamsConfig(ConfigPartial{"scheduler_id": "scheduler_id"}); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if check := params.SchedulerID; check != "scheduler_id" {
+			t.Errorf("stored (%v) trigger scheduler ID", check)
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package servlet
+
+import (
+	"context"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_NewTriggerProvider(t *testing.T) {
+	t.Run("without params", func(t *testing.T) {
+		if provider := NewTriggerProvider(nil); provider == nil {
+			t.Error("didn't returned a valid reference")
+		} else if !reflect.DeepEqual(NewTriggerProviderParams(), provider.params) {
+			t.Errorf("stored the (%v) parameters", provider.params)
+		}
+	})
+
+	t.Run("with defined params", func(t *testing.T) {
+		params := NewTriggerProviderParams()
+		if provider := NewTriggerProvider(params); provider == nil {
+			t.Error("didn't returned a valid reference")
+		} else if params != provider.params {
+			t.Errorf("stored the (%v) parameters", provider.params)
+		}
+	})
+}
+
+func Test_TriggerProvider_Provides(t *testing.T) {
+	t.Run("retrieve the provided entries", func(t *testing.T) {
+		params := NewTriggerProviderParams()
+		params.SchedulerID = "id"
+
+		if provides := NewTriggerProvider(params).Provides(); !reflect.DeepEqual(provides, []string{"id"}) {
+			t.Errorf("returned the (%v) list", provides)
+		}
+	})
+}
+
+func Test_TriggerProvider_Requires(t *testing.T) {
+	t.Run("retrieve the required entries", func(t *testing.T) {
+		if requires := NewTriggerProvider(nil).Requires(); len(requires) != 0 {
+			t.Errorf("returned the (%v) list", requires)
+		}
+	})
+}
+
+func Test_TriggerProvider_Register(t *testing.T) {
+	t.Run("nil container", func(t *testing.T) {
+		if err := NewTriggerProvider(nil).Register(nil); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'container' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("register the trigger scheduler", func(t *testing.T) {
+		container := NewAppContainer()
+		defer func() { _ = container.Close() }()
+
+		if err := NewTriggerProvider(nil).Register(container); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if scheduler, err := container.Get(ContainerTriggerSchedulerID); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else {
+			switch scheduler.(type) {
+			case *TriggerScheduler:
+			default:
+				t.Error("didn't returned the scheduler from the container")
+			}
+		}
+	})
+
+	t.Run("stop the scheduler jobs on container close", func(t *testing.T) {
+		check := int32(0)
+
+		container := NewAppContainer()
+		_ = NewTriggerProvider(nil).Register(container)
+
+		scheduler, _ := container.Get(ContainerTriggerSchedulerID)
+		_ = scheduler.(*TriggerScheduler).AddRecurring("job", 10*time.Millisecond, TriggerFailurePolicy{}, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return nil
+		})
+
+		_ = container.Close()
+		executed := atomic.LoadInt32(&check)
+
+		time.Sleep(40 * time.Millisecond)
+		if atomic.LoadInt32(&check) != executed {
+			t.Error("didn't stopped the scheduler jobs")
+		} else if scheduler.(*TriggerScheduler).Has("job") {
+			t.Error("didn't removed the scheduler jobs")
+		}
+	})
+}
+
+func Test_TriggerProvider_Boot(t *testing.T) {
+	container := NewAppContainer()
+
+	p := NewTriggerProvider(nil)
+	_ = p.Register(container)
+
+	if err := p.Boot(container); err != nil {
+		t.Errorf("returned the (%v) error", err)
+	}
+}
@@ -19,26 +19,23 @@ func NewTriggerPulse(delay time.Duration, callback TriggerCallback) (*TriggerPul
 	}
 
 	t := &TriggerPulse{
-		Trigger: Trigger{
-			timer:       delay,
-			callback:    callback,
-			isStopped:   false,
-			channelStop: make(chan bool),
-		},
+		Trigger: newTrigger(delay, callback),
 	}
 
 	go func() {
-		for {
-			select {
-			case <-time.After(t.timer):
-				if !t.isStopped {
-					t.isStopped = true
-					_ = t.callback()
-				}
-				return
-			case <-t.channelStop:
-				return
+		defer close(t.done)
+
+		t.plan(time.Now().Add(t.timer))
+		timer := time.NewTimer(t.timer)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			t.plan(time.Time{})
+			if t.ctx.Err() == nil {
+				_ = t.execute()
 			}
+		case <-t.ctx.Done():
 		}
 	}()
 
@@ -1,6 +1,7 @@
 package servlet
 
 import (
+	"context"
 	"testing"
 	"time"
 )
@@ -18,7 +19,7 @@ func Test_NewTriggerPulse(t *testing.T) {
 	})
 
 	t.Run("new pulse trigger", func(t *testing.T) {
-		if trigger, err := NewTriggerPulse(20*time.Millisecond, func() error {
+		if trigger, err := NewTriggerPulse(20*time.Millisecond, func(context.Context) error {
 			return nil
 		}); trigger == nil {
 			t.Error("didn't returned a valid reference")
@@ -35,7 +36,7 @@ func Test_TriggerPulse_Close(t *testing.T) {
 	t.Run("is the same as stopping it", func(t *testing.T) {
 		check := false
 
-		trigger, _ := NewTriggerPulse(20*time.Millisecond, func() error {
+		trigger, _ := NewTriggerPulse(20*time.Millisecond, func(context.Context) error {
 			check = true
 			return nil
 		})
@@ -52,7 +53,7 @@ func Test_TriggerPulse_Timer(t *testing.T) {
 	t.Run("retrieves the trigger time", func(t *testing.T) {
 		duration := 20 * time.Millisecond
 
-		trigger, _ := NewTriggerPulse(duration, func() error {
+		trigger, _ := NewTriggerPulse(duration, func(context.Context) error {
 			return nil
 		})
 		defer trigger.Close()
@@ -65,7 +66,7 @@ func Test_TriggerPulse_Timer(t *testing.T) {
 
 func Test_TriggerPulse_IsStopped(t *testing.T) {
 	t.Run("return false if called after creation", func(t *testing.T) {
-		trigger, _ := NewTriggerPulse(20*time.Millisecond, func() error {
+		trigger, _ := NewTriggerPulse(20*time.Millisecond, func(context.Context) error {
 			return nil
 		})
 		defer trigger.Close()
@@ -76,7 +77,7 @@ func Test_TriggerPulse_IsStopped(t *testing.T) {
 	})
 
 	t.Run("return true after calling Stop method", func(t *testing.T) {
-		trigger, _ := NewTriggerPulse(20*time.Millisecond, func() error {
+		trigger, _ := NewTriggerPulse(20*time.Millisecond, func(context.Context) error {
 			return nil
 		})
 		defer trigger.Close()
@@ -92,7 +93,7 @@ func Test_TriggerPulse_Stop(t *testing.T) {
 	t.Run("prevent triggering if called prior to the first execution", func(t *testing.T) {
 		check := false
 
-		trigger, _ := NewTriggerPulse(20*time.Millisecond, func() error {
+		trigger, _ := NewTriggerPulse(20*time.Millisecond, func(context.Context) error {
 			check = true
 			return nil
 		})
@@ -110,13 +111,15 @@ func Test_TriggerPulse(t *testing.T) {
 	t.Run("only trigger execution once", func(t *testing.T) {
 		check := 0
 
-		trigger, _ := NewTriggerPulse(20*time.Millisecond, func() error {
+		trigger, _ := NewTriggerPulse(20*time.Millisecond, func(context.Context) error {
 			check = check + 1
 			return nil
 		})
 		defer trigger.Close()
 
 		time.Sleep(100 * time.Millisecond)
+		trigger.Stop()
+		trigger.Wait()
 
 		if check == 0 {
 			t.Error("didn't called the callback function once")
@@ -9,40 +9,164 @@ import (
 // periodically with a defined frequency.
 type TriggerRecurring struct {
 	Trigger
+	schedule TriggerSchedulePolicy
 }
 
 // NewTriggerRecurring instantiate a new trigger that will execute a
-// callback method recurrently with a defined periodicity.
+// callback method recurrently with a defined periodicity. The callback
+// errors are handled as defined by the trigger failure policy, that stops
+// the trigger on the first error by default. The activation times are
+// calculated as defined by the trigger schedule policy, that counts the
+// period from the end of the previous execution by default.
 func NewTriggerRecurring(period time.Duration, callback TriggerCallback) (*TriggerRecurring, error) {
+	return NewTriggerRecurringWithPolicies(period, TriggerSchedulePolicy{}, TriggerFailurePolicy{}, callback)
+}
+
+// NewTriggerRecurringWithPolicies instantiate a new recurring trigger with
+// the given schedule and failure policies, that are already applied to the
+// first activation of the trigger.
+func NewTriggerRecurringWithPolicies(period time.Duration, schedule TriggerSchedulePolicy, failure TriggerFailurePolicy, callback TriggerCallback) (*TriggerRecurring, error) {
 	if callback == nil {
 		return nil, fmt.Errorf("invalid nil 'callback' argument")
 	}
 
 	t := &TriggerRecurring{
-		Trigger: Trigger{
-			timer:       period,
-			callback:    callback,
-			isStopped:   false,
-			channelStop: make(chan bool),
-		},
+		Trigger:  newTrigger(period, callback),
+		schedule: schedule,
 	}
+	t.failure = failure
+
+	go t.run()
+
+	return t, nil
+}
+
+// SetSchedulePolicy will define how the trigger activation times are
+// calculated and how overlapping executions are handled. As the trigger is
+// already running, the policy may not be applied to the first activation,
+// so the NewTriggerRecurringWithPolicies constructor should be used to
+// define the policy of the trigger from its creation.
+func (t *TriggerRecurring) SetSchedulePolicy(policy TriggerSchedulePolicy) {
+	if t == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.schedule = policy
+}
 
-	go func() {
-		for {
+func (t *TriggerRecurring) policy() TriggerSchedulePolicy {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.schedule
+}
+
+func (t *TriggerRecurring) run() {
+	defer close(t.done)
+
+	finished := make(chan error)
+	running := 0
+	queued := false
+	failures := 0
+
+	policy := t.policy()
+	next := time.Now().Add(t.timer)
+	wait := policy.delay(t.timer)
+	t.plan(time.Now().Add(wait))
+	timer := time.NewTimer(wait)
+	tick := timer.C
+
+	arm := func(wait time.Duration) {
+		if !timer.Stop() {
 			select {
-			case <-time.After(t.timer):
-				if !t.isStopped {
-					if err := t.callback(); err != nil {
-						t.isStopped = true
-						return
-					}
-				}
-			case <-t.channelStop:
-				t.isStopped = true
-				return
+			case <-timer.C:
+			default:
 			}
 		}
+		t.plan(time.Now().Add(wait))
+		timer.Reset(wait)
+		tick = timer.C
+	}
+	schedule := func() {
+		now := time.Now()
+		for t.timer > 0 && !next.After(now) {
+			next = next.Add(t.timer)
+		}
+		arm(policy.delay(time.Until(next)))
+	}
+	start := func() {
+		running++
+		go func() { finished <- t.execute() }()
+	}
+
+	defer func() {
+		timer.Stop()
+		for ; running > 0; running-- {
+			<-finished
+		}
 	}()
 
-	return t, nil
+	for {
+		select {
+		case <-tick:
+			if t.ctx.Err() != nil {
+				return
+			}
+
+			tick = nil
+			t.plan(time.Time{})
+			policy = t.policy()
+			if policy.Mode == TriggerScheduleFixedRate {
+				schedule()
+			}
+
+			switch {
+			case policy.accepts(running):
+				start()
+			case policy.Overlap == TriggerOverlapQueue:
+				queued = true
+			}
+		case err := <-finished:
+			running--
+
+			var delay time.Duration
+			if err == nil {
+				failures = 0
+			} else {
+				failures++
+				wait, ok := t.fail(failures)
+				if !ok {
+					t.cancel()
+					return
+				}
+				delay = wait
+			}
+
+			policy = t.policy()
+			switch {
+			case policy.Mode != TriggerScheduleFixedRate:
+				if delay == 0 {
+					delay = t.timer
+				}
+				arm(policy.delay(delay))
+			case delay > 0:
+				queued = false
+				next = time.Now().Add(delay)
+				arm(policy.delay(delay))
+			default:
+				if tick == nil {
+					schedule()
+				}
+				if queued && policy.accepts(running) && t.ctx.Err() == nil {
+					queued = false
+					start()
+				}
+			}
+		case <-t.ctx.Done():
+			return
+		}
+	}
 }
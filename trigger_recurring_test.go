@@ -1,7 +1,10 @@
 package servlet
 
 import (
+	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -19,7 +22,7 @@ func Test_NewTriggerRecurring(t *testing.T) {
 	})
 
 	t.Run("new recurring trigger", func(t *testing.T) {
-		if trigger, err := NewTriggerRecurring(20*time.Millisecond, func() error {
+		if trigger, err := NewTriggerRecurring(20*time.Millisecond, func(context.Context) error {
 			return nil
 		}); trigger == nil {
 			t.Error("didn't returned a valid reference")
@@ -32,10 +35,59 @@ func Test_NewTriggerRecurring(t *testing.T) {
 	})
 }
 
+func Test_NewTriggerRecurringWithPolicies(t *testing.T) {
+	t.Run("nil callback", func(t *testing.T) {
+		if trigger, err := NewTriggerRecurringWithPolicies(20*time.Millisecond, TriggerSchedulePolicy{}, TriggerFailurePolicy{}, nil); trigger != nil {
+			defer trigger.Close()
+			t.Error("returned a valid reference")
+		} else if err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'callback' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("apply the schedule policy to the first activation", func(t *testing.T) {
+		check := int32(0)
+
+		policy := TriggerSchedulePolicy{Jitter: time.Hour}
+		trigger, _ := NewTriggerRecurringWithPolicies(10*time.Millisecond, policy, TriggerFailurePolicy{}, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return nil
+		})
+		defer trigger.Close()
+
+		time.Sleep(50 * time.Millisecond)
+
+		if c := atomic.LoadInt32(&check); c != 0 {
+			t.Errorf("executed the callback (%d) times", c)
+		}
+	})
+
+	t.Run("apply the failure policy to the first execution", func(t *testing.T) {
+		check := int32(0)
+
+		policy := TriggerFailurePolicy{Action: TriggerFailureContinue}
+		trigger, _ := NewTriggerRecurringWithPolicies(time.Millisecond, TriggerSchedulePolicy{}, policy, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return fmt.Errorf("__dummy_error__")
+		})
+		defer trigger.Close()
+
+		time.Sleep(50 * time.Millisecond)
+
+		if trigger.IsStopped() {
+			t.Error("stopped the trigger on the first callback error")
+		} else if c := atomic.LoadInt32(&check); c < 2 {
+			t.Errorf("executed the callback (%d) times", c)
+		}
+	})
+}
+
 func Test_TriggerRecurring_Close(t *testing.T) {
 	t.Run("is the same as stopping it", func(t *testing.T) {
 		check := false
-		trigger, _ := NewTriggerRecurring(20*time.Millisecond, func() error {
+		trigger, _ := NewTriggerRecurring(20*time.Millisecond, func(context.Context) error {
 			check = true
 			return nil
 		})
@@ -51,7 +103,7 @@ func Test_TriggerRecurring_Close(t *testing.T) {
 func Test_TriggerRecurring_Timer(t *testing.T) {
 	t.Run("retrieves the trigger interval duration", func(t *testing.T) {
 		duration := 20 * time.Millisecond
-		trigger, _ := NewTriggerRecurring(duration, func() error {
+		trigger, _ := NewTriggerRecurring(duration, func(context.Context) error {
 			return nil
 		})
 		defer trigger.Close()
@@ -64,7 +116,7 @@ func Test_TriggerRecurring_Timer(t *testing.T) {
 
 func Test_TriggerRecurring_IsStopped(t *testing.T) {
 	t.Run("return false if called after creation", func(t *testing.T) {
-		trigger, _ := NewTriggerRecurring(20*time.Millisecond, func() error {
+		trigger, _ := NewTriggerRecurring(20*time.Millisecond, func(context.Context) error {
 			return nil
 		})
 		defer trigger.Close()
@@ -75,7 +127,7 @@ func Test_TriggerRecurring_IsStopped(t *testing.T) {
 	})
 
 	t.Run("return true after calling Stop method", func(t *testing.T) {
-		trigger, _ := NewTriggerRecurring(20*time.Millisecond, func() error {
+		trigger, _ := NewTriggerRecurring(20*time.Millisecond, func(context.Context) error {
 			return nil
 		})
 		defer trigger.Close()
@@ -92,7 +144,7 @@ func Test_TriggerRecurring_Stop(t *testing.T) {
 	t.Run("prevent triggering if called prior to first execution", func(t *testing.T) {
 		check := false
 
-		trigger, _ := NewTriggerRecurring(20*time.Millisecond, func() error { check = true; return nil })
+		trigger, _ := NewTriggerRecurring(20*time.Millisecond, func(context.Context) error { check = true; return nil })
 		defer trigger.Close()
 		trigger.Stop()
 
@@ -107,10 +159,12 @@ func Test_TriggerRecurring(t *testing.T) {
 	t.Run("run trigger multiple times", func(t *testing.T) {
 		check := 0
 
-		trigger, _ := NewTriggerRecurring(20*time.Millisecond, func() error { check = check + 1; return nil })
+		trigger, _ := NewTriggerRecurring(20*time.Millisecond, func(context.Context) error { check = check + 1; return nil })
 		defer trigger.Close()
 
 		time.Sleep(100 * time.Millisecond)
+		trigger.Stop()
+		trigger.Wait()
 
 		if check <= 2 {
 			t.Error("didn't recurrently called the callback function")
@@ -120,13 +174,217 @@ func Test_TriggerRecurring(t *testing.T) {
 	t.Run("stop the trigger on callback error", func(t *testing.T) {
 		check := 0
 
-		trigger, _ := NewTriggerRecurring(20*time.Millisecond, func() error { check = check + 1; return fmt.Errorf("__dummy_error__") })
+		trigger, _ := NewTriggerRecurring(20*time.Millisecond, func(context.Context) error { check = check + 1; return fmt.Errorf("__dummy_error__") })
 		defer trigger.Close()
 
 		time.Sleep(100 * time.Millisecond)
+		trigger.Wait()
 
 		if check != 1 {
 			t.Error("didn't stop recursion calls after the first error")
 		}
 	})
+	t.Run("keep executing on callback error with the continue policy", func(t *testing.T) {
+		check := int32(0)
+		reported := int32(0)
+
+		policy := TriggerFailurePolicy{Action: TriggerFailureContinue}
+		trigger, _ := NewTriggerRecurringWithPolicies(20*time.Millisecond, TriggerSchedulePolicy{}, policy, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return fmt.Errorf("__dummy_error__")
+		})
+		defer trigger.Close()
+		trigger.SetErrorHandler(func(error) { atomic.AddInt32(&reported, 1) })
+
+		time.Sleep(100 * time.Millisecond)
+		trigger.Stop()
+		trigger.Wait()
+
+		if atomic.LoadInt32(&check) <= 2 {
+			t.Error("didn't recurrently called the callback function")
+		} else if atomic.LoadInt32(&reported) != atomic.LoadInt32(&check) {
+			t.Error("didn't reported every callback error")
+		}
+	})
+
+	t.Run("stop the trigger after the max number of consecutive failures", func(t *testing.T) {
+		check := int32(0)
+
+		policy := TriggerFailurePolicy{Action: TriggerFailureContinue, MaxFailures: 2}
+		trigger, _ := NewTriggerRecurringWithPolicies(20*time.Millisecond, TriggerSchedulePolicy{}, policy, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return fmt.Errorf("__dummy_error__")
+		})
+		defer trigger.Close()
+
+		time.Sleep(100 * time.Millisecond)
+		trigger.Wait()
+
+		if atomic.LoadInt32(&check) != 2 {
+			t.Errorf("called the callback function (%d) times", atomic.LoadInt32(&check))
+		}
+	})
+
+	t.Run("reset the consecutive failures on callback success", func(t *testing.T) {
+		check := int32(0)
+
+		policy := TriggerFailurePolicy{Action: TriggerFailureContinue, MaxFailures: 2}
+		trigger, _ := NewTriggerRecurringWithPolicies(20*time.Millisecond, TriggerSchedulePolicy{}, policy, func(context.Context) error {
+			if atomic.AddInt32(&check, 1)%2 == 0 {
+				return nil
+			}
+			return fmt.Errorf("__dummy_error__")
+		})
+		defer trigger.Close()
+
+		time.Sleep(120 * time.Millisecond)
+		trigger.Stop()
+		trigger.Wait()
+
+		if atomic.LoadInt32(&check) <= 2 {
+			t.Error("stopped the trigger on non-consecutive failures")
+		}
+	})
+
+	t.Run("delay the execution on callback error with the backoff policy", func(t *testing.T) {
+		check := int32(0)
+
+		policy := TriggerFailurePolicy{Action: TriggerFailureBackoff, Backoff: 40 * time.Millisecond}
+		trigger, _ := NewTriggerRecurringWithPolicies(10*time.Millisecond, TriggerSchedulePolicy{}, policy, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return fmt.Errorf("__dummy_error__")
+		})
+		defer trigger.Close()
+
+		time.Sleep(100 * time.Millisecond)
+		trigger.Stop()
+		trigger.Wait()
+
+		if c := atomic.LoadInt32(&check); c < 1 || c > 3 {
+			t.Errorf("called the callback function (%d) times", c)
+		}
+	})
+	t.Run("fixed rate schedule is not delayed by the callback duration", func(t *testing.T) {
+		delayed := int32(0)
+		rated := int32(0)
+
+		delay, _ := NewTriggerRecurring(20*time.Millisecond, func(context.Context) error {
+			atomic.AddInt32(&delayed, 1)
+			time.Sleep(15 * time.Millisecond)
+			return nil
+		})
+		defer delay.Close()
+
+		policy := TriggerSchedulePolicy{Mode: TriggerScheduleFixedRate}
+		rate, _ := NewTriggerRecurringWithPolicies(20*time.Millisecond, policy, TriggerFailurePolicy{}, func(context.Context) error {
+			atomic.AddInt32(&rated, 1)
+			time.Sleep(15 * time.Millisecond)
+			return nil
+		})
+		defer rate.Close()
+
+		time.Sleep(210 * time.Millisecond)
+		delay.Stop()
+		rate.Stop()
+		delay.Wait()
+		rate.Wait()
+
+		if atomic.LoadInt32(&rated) <= atomic.LoadInt32(&delayed) {
+			t.Errorf("fixed rate executed (%d) times and fixed delay executed (%d) times", rated, delayed)
+		}
+	})
+
+	// with a 20ms period and a 25ms execution, a skipping trigger executes
+	// on every other activation (~5 times in 200ms), a queueing trigger
+	// executes back to back (~8 times) and a concurrent trigger executes on
+	// every activation (~9 times)
+	scenarios := []struct {
+		name       string
+		policy     TriggerSchedulePolicy
+		concurrent int32
+		min        int32
+		max        int32
+	}{
+		{ // test skip overlap
+			name:       "skip activations while the callback is executing",
+			policy:     TriggerSchedulePolicy{Mode: TriggerScheduleFixedRate, Overlap: TriggerOverlapSkip},
+			concurrent: 1,
+			min:        3,
+			max:        5,
+		},
+		{ // test queue overlap
+			name:       "queue an activation while the callback is executing",
+			policy:     TriggerSchedulePolicy{Mode: TriggerScheduleFixedRate, Overlap: TriggerOverlapQueue},
+			concurrent: 1,
+			min:        6,
+			max:        8,
+		},
+		{ // test concurrent overlap
+			name:       "concurrently execute the callback up to the defined limit",
+			policy:     TriggerSchedulePolicy{Mode: TriggerScheduleFixedRate, Overlap: TriggerOverlapConcurrent, MaxConcurrent: 2},
+			concurrent: 2,
+			min:        7,
+			max:        10,
+		},
+	}
+
+	for _, scn := range scenarios {
+		scn := scn
+		t.Run(scn.name, func(t *testing.T) {
+			executions := int32(0)
+			running := int32(0)
+			max := int32(0)
+			mutex := &sync.Mutex{}
+
+			trigger, _ := NewTriggerRecurringWithPolicies(20*time.Millisecond, scn.policy, TriggerFailurePolicy{}, func(context.Context) error {
+				mutex.Lock()
+				executions++
+				running++
+				if running > max {
+					max = running
+				}
+				mutex.Unlock()
+
+				time.Sleep(25 * time.Millisecond)
+
+				mutex.Lock()
+				running--
+				mutex.Unlock()
+				return nil
+			})
+			defer trigger.Close()
+
+			time.Sleep(200 * time.Millisecond)
+			trigger.Stop()
+			trigger.Wait()
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if max != scn.concurrent {
+				t.Errorf("executed (%d) concurrent callbacks", max)
+			} else if executions < scn.min || executions > scn.max {
+				t.Errorf("executed the callback (%d) times", executions)
+			}
+		})
+	}
+
+	t.Run("queued activation is executed right after the running execution", func(t *testing.T) {
+		check := int32(0)
+
+		policy := TriggerSchedulePolicy{Mode: TriggerScheduleFixedRate, Overlap: TriggerOverlapQueue}
+		trigger, _ := NewTriggerRecurringWithPolicies(20*time.Millisecond, policy, TriggerFailurePolicy{}, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			time.Sleep(30 * time.Millisecond)
+			return nil
+		})
+		defer trigger.Close()
+
+		time.Sleep(145 * time.Millisecond)
+		trigger.Stop()
+		trigger.Wait()
+
+		if c := atomic.LoadInt32(&check); c < 4 {
+			t.Errorf("executed the callback (%d) times", c)
+		}
+	})
 }
@@ -0,0 +1,62 @@
+package servlet
+
+import (
+	"math/rand"
+	"time"
+)
+
+// TriggerScheduleMode identifies a value type that describes how the
+// activation times of a recurring trigger are calculated.
+type TriggerScheduleMode int
+
+const (
+	// TriggerScheduleFixedDelay defines that the trigger period is counted
+	// from the end of the previous callback execution.
+	TriggerScheduleFixedDelay TriggerScheduleMode = iota
+	// TriggerScheduleFixedRate defines that the trigger is activated on
+	// every period from the trigger creation, independently of the
+	// callback execution duration.
+	TriggerScheduleFixedRate
+)
+
+// TriggerOverlapAction identifies a value type that describes how a fixed
+// rate trigger reacts to an activation while the callback is still
+// executing.
+type TriggerOverlapAction int
+
+const (
+	// TriggerOverlapSkip defines that the activation is discarded.
+	TriggerOverlapSkip TriggerOverlapAction = iota
+	// TriggerOverlapQueue defines that a single activation is kept and
+	// executed as soon as the running execution terminates.
+	TriggerOverlapQueue
+	// TriggerOverlapConcurrent defines that the callback is executed
+	// concurrently, up to the MaxConcurrent number of executions (no limit
+	// if not defined). Activations above the limit are discarded.
+	TriggerOverlapConcurrent
+)
+
+// TriggerSchedulePolicy defines the scheduling policy of a recurring
+// trigger. The Jitter value, if defined, is the upper limit of a random
+// delay added to every activation, so that the executions of several
+// instances of the same process can be spread in time.
+type TriggerSchedulePolicy struct {
+	Mode          TriggerScheduleMode
+	Jitter        time.Duration
+	Overlap       TriggerOverlapAction
+	MaxConcurrent int
+}
+
+func (p TriggerSchedulePolicy) delay(wait time.Duration) time.Duration {
+	if p.Jitter > 0 {
+		wait += time.Duration(rand.Int63n(int64(p.Jitter)))
+	}
+	return wait
+}
+
+func (p TriggerSchedulePolicy) accepts(running int) bool {
+	if p.Overlap == TriggerOverlapConcurrent {
+		return p.MaxConcurrent <= 0 || running < p.MaxConcurrent
+	}
+	return running == 0
+}
@@ -0,0 +1,71 @@
+package servlet
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_TriggerSchedulePolicy_delay(t *testing.T) {
+	t.Run("no jitter", func(t *testing.T) {
+		policy := TriggerSchedulePolicy{}
+
+		if delay := policy.delay(time.Second); delay != time.Second {
+			t.Errorf("returned (%v) delay", delay)
+		}
+	})
+
+	t.Run("jitter", func(t *testing.T) {
+		policy := TriggerSchedulePolicy{Jitter: 100 * time.Millisecond}
+
+		for i := 0; i < 100; i++ {
+			if delay := policy.delay(time.Second); delay < time.Second || delay >= time.Second+policy.Jitter {
+				t.Errorf("returned (%v) delay", delay)
+			}
+		}
+	})
+}
+
+func Test_TriggerSchedulePolicy_accepts(t *testing.T) {
+	scenarios := []struct {
+		policy   TriggerSchedulePolicy
+		running  int
+		expected bool
+	}{
+		{ // test skip overlap without running executions
+			policy:   TriggerSchedulePolicy{Overlap: TriggerOverlapSkip},
+			running:  0,
+			expected: true,
+		},
+		{ // test skip overlap with a running execution
+			policy:   TriggerSchedulePolicy{Overlap: TriggerOverlapSkip},
+			running:  1,
+			expected: false,
+		},
+		{ // test queue overlap with a running execution
+			policy:   TriggerSchedulePolicy{Overlap: TriggerOverlapQueue},
+			running:  1,
+			expected: false,
+		},
+		{ // test concurrent overlap without limit
+			policy:   TriggerSchedulePolicy{Overlap: TriggerOverlapConcurrent},
+			running:  10,
+			expected: true,
+		},
+		{ // test concurrent overlap bellow the limit
+			policy:   TriggerSchedulePolicy{Overlap: TriggerOverlapConcurrent, MaxConcurrent: 2},
+			running:  1,
+			expected: true,
+		},
+		{ // test concurrent overlap reaching the limit
+			policy:   TriggerSchedulePolicy{Overlap: TriggerOverlapConcurrent, MaxConcurrent: 2},
+			running:  2,
+			expected: false,
+		},
+	}
+
+	for _, scn := range scenarios {
+		if check := scn.policy.accepts(scn.running); check != scn.expected {
+			t.Errorf("(%v) returned (%v) for (%d) running executions", scn.policy, check, scn.running)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package servlet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+type triggerSchedulerEntry struct {
+	factory  TriggerFactory
+	callback TriggerCallback
+	trigger  *Trigger
+	last     time.Time
+}
+
+// TriggerScheduler defines a manager of named trigger jobs. The scheduler
+// jobs can be listed, paused, resumed, manually executed and removed, and
+// are all stopped when the scheduler is closed.
+type TriggerScheduler struct {
+	control sync.Locker
+	mutex   sync.Locker
+	jobs    map[string]*triggerSchedulerEntry
+}
+
+// NewTriggerScheduler will instantiate a new scheduler without jobs.
+func NewTriggerScheduler() *TriggerScheduler {
+	return &TriggerScheduler{
+		control: &sync.Mutex{},
+		mutex:   &sync.Mutex{},
+		jobs:    map[string]*triggerSchedulerEntry{},
+	}
+}
+
+// Close will stop all the scheduler jobs and remove them from the
+// scheduler, waiting for the termination of any running execution. The
+// scheduler can be used by the running executions while closing, as the
+// removed jobs are no longer reachable.
+func (s *TriggerScheduler) Close() {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	s.control.Lock()
+	s.mutex.Lock()
+	jobs := s.jobs
+	s.jobs = map[string]*triggerSchedulerEntry{}
+	s.mutex.Unlock()
+	s.control.Unlock()
+
+	for _, job := range jobs {
+		if job.trigger != nil {
+			job.trigger.Stop()
+		}
+	}
+	for _, job := range jobs {
+		if job.trigger != nil {
+			job.trigger.Wait()
+		}
+	}
+}
+
+// Has will check if a job with the given name is registered.
+func (s *TriggerScheduler) Has(name string) bool {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, ok := s.jobs[name]
+	return ok
+}
+
+// Add will register a new job with the given name, which trigger is
+// instantiated by the given factory and started immediately.
+func (s *TriggerScheduler) Add(name string, factory TriggerFactory, callback TriggerCallback) error {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	if factory == nil {
+		return fmt.Errorf("invalid nil 'factory' argument")
+	}
+	if callback == nil {
+		return fmt.Errorf("invalid nil 'callback' argument")
+	}
+
+	s.control.Lock()
+	defer s.control.Unlock()
+
+	if _, ok := s.jobs[name]; ok {
+		return fmt.Errorf("job '%s' already registered", name)
+	}
+
+	job := &triggerSchedulerEntry{
+		factory:  factory,
+		callback: callback,
+	}
+
+	trigger, err := s.start(name, job)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	job.trigger = trigger
+	s.jobs[name] = job
+
+	return nil
+}
+
+// AddRecurring will register a new job executed by a recurring trigger
+// with the given period and callback failure policy.
+func (s *TriggerScheduler) AddRecurring(name string, period time.Duration, policy TriggerFailurePolicy, callback TriggerCallback) error {
+	return s.Add(name, func(callback TriggerCallback) (*Trigger, error) {
+		t, err := NewTriggerRecurringWithPolicies(period, TriggerSchedulePolicy{}, policy, callback)
+		if err != nil {
+			return nil, err
+		}
+		return &t.Trigger, nil
+	}, callback)
+}
+
+// AddCron will register a new job executed by a cron trigger with the
+// given expression, evaluated in the given location, and callback failure
+// policy.
+func (s *TriggerScheduler) AddCron(name, expression string, location *time.Location, policy TriggerFailurePolicy, callback TriggerCallback) error {
+	return s.Add(name, func(callback TriggerCallback) (*Trigger, error) {
+		t, err := NewTriggerCronWithPolicy(expression, location, policy, callback)
+		if err != nil {
+			return nil, err
+		}
+		return &t.Trigger, nil
+	}, callback)
+}
+
+// Remove will stop and remove the job with the given name. A running
+// execution is signaled to stop through the cancellation of its context,
+// but it is not waited for, so a job can remove itself.
+func (s *TriggerScheduler) Remove(name string) error {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	s.control.Lock()
+	defer s.control.Unlock()
+
+	job, ok := s.jobs[name]
+	if !ok {
+		return fmt.Errorf("job '%s' not registered", name)
+	}
+
+	s.mutex.Lock()
+	delete(s.jobs, name)
+	s.mutex.Unlock()
+
+	if job.trigger != nil {
+		job.trigger.Stop()
+	}
+
+	return nil
+}
+
+// Pause will stop the trigger of the job with the given name. As in the
+// job removal, a running execution is signaled to stop but it is not
+// waited for. Pausing an already paused job has no effect.
+func (s *TriggerScheduler) Pause(name string) error {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	s.control.Lock()
+	defer s.control.Unlock()
+
+	job, ok := s.jobs[name]
+	if !ok {
+		return fmt.Errorf("job '%s' not registered", name)
+	}
+
+	s.mutex.Lock()
+	trigger := job.trigger
+	job.trigger = nil
+	s.mutex.Unlock()
+
+	if trigger != nil {
+		trigger.Stop()
+	}
+
+	return nil
+}
+
+// Resume will restart the trigger of the paused job with the given name,
+// or of the job which trigger has stopped by itself, as by a callback
+// error or the activation of a pulse trigger. Resuming a job with a
+// running trigger has no effect.
+func (s *TriggerScheduler) Resume(name string) error {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	s.control.Lock()
+	defer s.control.Unlock()
+
+	job, ok := s.jobs[name]
+	if !ok {
+		return fmt.Errorf("job '%s' not registered", name)
+	}
+
+	if job.trigger != nil && !job.trigger.IsStopped() {
+		return nil
+	}
+
+	trigger, err := s.start(name, job)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	job.trigger = trigger
+
+	return nil
+}
+
+// Run will execute the callback of the job with the given name in the
+// calling goroutine, independently of the job trigger schedule or state.
+func (s *TriggerScheduler) Run(ctx context.Context, name string) error {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	if ctx == nil {
+		return fmt.Errorf("invalid nil 'ctx' argument")
+	}
+
+	s.mutex.Lock()
+	job, ok := s.jobs[name]
+	s.mutex.Unlock()
+
+	if !ok {
+		return fmt.Errorf("job '%s' not registered", name)
+	}
+
+	return s.callback(job)(ctx)
+}
+
+// List will retrieve the state information of all the registered jobs,
+// sorted by name.
+func (s *TriggerScheduler) List() []TriggerSchedulerJob {
+	if s == nil {
+		panic(fmt.Errorf("nil pointer receiver"))
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	list := make([]TriggerSchedulerJob, 0, len(s.jobs))
+	for name, job := range s.jobs {
+		info := TriggerSchedulerJob{
+			Name:    name,
+			Paused:  job.trigger == nil,
+			LastRun: job.last,
+		}
+		if job.trigger != nil {
+			info.Stopped = job.trigger.IsStopped()
+			info.NextRun = job.trigger.NextRun()
+		}
+		list = append(list, info)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
+
+func (s *TriggerScheduler) start(name string, job *triggerSchedulerEntry) (*Trigger, error) {
+	trigger, err := job.factory(s.callback(job))
+	if err != nil {
+		return nil, fmt.Errorf("job '%s' trigger error : %w", name, err)
+	}
+	if trigger == nil {
+		return nil, fmt.Errorf("job '%s' factory returned a nil trigger", name)
+	}
+	return trigger, nil
+}
+
+func (s *TriggerScheduler) callback(job *triggerSchedulerEntry) TriggerCallback {
+	return func(ctx context.Context) error {
+		s.mutex.Lock()
+		job.last = time.Now()
+		s.mutex.Unlock()
+
+		return job.callback(ctx)
+	}
+}
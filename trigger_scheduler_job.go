@@ -0,0 +1,15 @@
+package servlet
+
+import "time"
+
+// TriggerSchedulerJob defines the state information of a job managed by a
+// trigger scheduler. A job is flagged as stopped if its trigger terminated
+// by itself, as by a callback error, and won't be executed again unless
+// resumed.
+type TriggerSchedulerJob struct {
+	Name    string
+	Paused  bool
+	Stopped bool
+	NextRun time.Time
+	LastRun time.Time
+}
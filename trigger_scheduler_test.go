@@ -0,0 +1,549 @@
+package servlet
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_NewTriggerScheduler(t *testing.T) {
+	t.Run("new scheduler", func(t *testing.T) {
+		if scheduler := NewTriggerScheduler(); scheduler == nil {
+			t.Error("didn't returned a valid reference")
+		} else if len(scheduler.List()) != 0 {
+			t.Error("didn't instantiated an empty scheduler")
+		}
+	})
+}
+
+func Test_TriggerScheduler_Close(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var scheduler *TriggerScheduler
+		scheduler.Close()
+	})
+
+	t.Run("stop and remove all the jobs", func(t *testing.T) {
+		check := int32(0)
+		callback := func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return nil
+		}
+
+		scheduler := NewTriggerScheduler()
+		_ = scheduler.AddRecurring("job1", 10*time.Millisecond, TriggerFailurePolicy{}, callback)
+		_ = scheduler.AddRecurring("job2", 10*time.Millisecond, TriggerFailurePolicy{}, callback)
+
+		time.Sleep(30 * time.Millisecond)
+		scheduler.Close()
+		executed := atomic.LoadInt32(&check)
+
+		time.Sleep(30 * time.Millisecond)
+		if atomic.LoadInt32(&check) != executed {
+			t.Error("didn't stopped the jobs")
+		} else if len(scheduler.List()) != 0 {
+			t.Error("didn't removed the jobs")
+		}
+	})
+
+	t.Run("allow a running job to control the scheduler while closing", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		result := make(chan error, 1)
+
+		scheduler := NewTriggerScheduler()
+		_ = scheduler.AddRecurring("other", time.Hour, TriggerFailurePolicy{}, func(context.Context) error { return nil })
+		_ = scheduler.AddRecurring("job", 10*time.Millisecond, TriggerFailurePolicy{}, func(context.Context) error {
+			select {
+			case <-started:
+				return nil
+			default:
+				close(started)
+			}
+			<-release
+			result <- scheduler.Pause("other")
+			return nil
+		})
+
+		<-started
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			scheduler.Close()
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+
+		select {
+		case <-closed:
+		case <-time.After(time.Second):
+			t.Fatal("deadlocked the scheduler close")
+		}
+
+		if err := <-result; err == nil || err.Error() != "job 'other' not registered" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+}
+
+func Test_TriggerScheduler_Add(t *testing.T) {
+	callback := func(context.Context) error { return nil }
+	factory := func(callback TriggerCallback) (*Trigger, error) {
+		trigger, err := NewTriggerPulse(time.Second, callback)
+		if err != nil {
+			return nil, err
+		}
+		return &trigger.Trigger, nil
+	}
+
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var scheduler *TriggerScheduler
+		_ = scheduler.Add("job", factory, callback)
+	})
+
+	t.Run("nil factory", func(t *testing.T) {
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		if err := scheduler.Add("job", nil, callback); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'factory' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("nil callback", func(t *testing.T) {
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		if err := scheduler.Add("job", factory, nil); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'callback' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error on duplicate job name", func(t *testing.T) {
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		_ = scheduler.Add("job", factory, callback)
+		if err := scheduler.Add("job", factory, callback); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "job 'job' already registered" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error on factory error", func(t *testing.T) {
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		if err := scheduler.Add("job", func(TriggerCallback) (*Trigger, error) {
+			return nil, fmt.Errorf("__dummy_error__")
+		}, callback); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "job 'job' trigger error : __dummy_error__" {
+			t.Errorf("returned the (%v) error", err)
+		} else if scheduler.Has("job") {
+			t.Error("registered the job")
+		}
+	})
+
+	t.Run("error on nil factory trigger", func(t *testing.T) {
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		if err := scheduler.Add("job", func(TriggerCallback) (*Trigger, error) {
+			return nil, nil
+		}, callback); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "job 'job' factory returned a nil trigger" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("register and start the job", func(t *testing.T) {
+		executed := make(chan struct{}, 1)
+
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		if err := scheduler.AddRecurring("job", 10*time.Millisecond, TriggerFailurePolicy{}, func(context.Context) error {
+			select {
+			case executed <- struct{}{}:
+			default:
+			}
+			return nil
+		}); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !scheduler.Has("job") {
+			t.Error("didn't registered the job")
+		}
+
+		select {
+		case <-executed:
+		case <-time.After(100 * time.Millisecond):
+			t.Error("didn't started the job")
+		}
+	})
+
+	t.Run("apply the job failure policy", func(t *testing.T) {
+		check := int32(0)
+
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		policy := TriggerFailurePolicy{Action: TriggerFailureContinue}
+		_ = scheduler.AddRecurring("job", 10*time.Millisecond, policy, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return fmt.Errorf("__dummy_error__")
+		})
+
+		time.Sleep(50 * time.Millisecond)
+		if c := atomic.LoadInt32(&check); c < 2 {
+			t.Errorf("executed the job (%d) times", c)
+		} else if list := scheduler.List(); len(list) != 1 || list[0].Stopped {
+			t.Errorf("returned the (%v) job list", list)
+		}
+	})
+
+	t.Run("error on invalid cron expression", func(t *testing.T) {
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		if err := scheduler.AddCron("job", "invalid", nil, TriggerFailurePolicy{}, callback); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "job 'job' trigger error : invalid 'invalid' cron expression" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("register a cron job", func(t *testing.T) {
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		if err := scheduler.AddCron("job", "@yearly", time.UTC, TriggerFailurePolicy{}, callback); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if !scheduler.Has("job") {
+			t.Error("didn't registered the job")
+		}
+	})
+}
+
+func Test_TriggerScheduler_Remove(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var scheduler *TriggerScheduler
+		_ = scheduler.Remove("job")
+	})
+
+	t.Run("error on unknown job", func(t *testing.T) {
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		if err := scheduler.Remove("job"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "job 'job' not registered" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("allow a job to remove itself", func(t *testing.T) {
+		result := make(chan error, 1)
+
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		_ = scheduler.AddRecurring("job", 10*time.Millisecond, TriggerFailurePolicy{}, func(context.Context) error {
+			result <- scheduler.Remove("job")
+			return nil
+		})
+
+		select {
+		case err := <-result:
+			if err != nil {
+				t.Errorf("returned the (%v) error", err)
+			} else if scheduler.Has("job") {
+				t.Error("didn't removed the job")
+			}
+		case <-time.After(time.Second):
+			t.Fatal("deadlocked the job removal")
+		}
+	})
+
+	t.Run("stop and remove the job", func(t *testing.T) {
+		check := int32(0)
+
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		_ = scheduler.AddRecurring("job", 10*time.Millisecond, TriggerFailurePolicy{}, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return nil
+		})
+
+		if err := scheduler.Remove("job"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if scheduler.Has("job") {
+			t.Error("didn't removed the job")
+		}
+
+		executed := atomic.LoadInt32(&check)
+		time.Sleep(30 * time.Millisecond)
+		if atomic.LoadInt32(&check) != executed {
+			t.Error("didn't stopped the job")
+		}
+	})
+}
+
+func Test_TriggerScheduler_Pause(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var scheduler *TriggerScheduler
+		_ = scheduler.Pause("job")
+	})
+
+	t.Run("error on unknown job", func(t *testing.T) {
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		if err := scheduler.Pause("job"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "job 'job' not registered" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("stop the job execution", func(t *testing.T) {
+		check := int32(0)
+
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		_ = scheduler.AddRecurring("job", 10*time.Millisecond, TriggerFailurePolicy{}, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return nil
+		})
+
+		if err := scheduler.Pause("job"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if err := scheduler.Pause("job"); err != nil {
+			t.Errorf("returned the (%v) error on an already paused job", err)
+		}
+
+		executed := atomic.LoadInt32(&check)
+		time.Sleep(30 * time.Millisecond)
+		if atomic.LoadInt32(&check) != executed {
+			t.Error("didn't stopped the job")
+		} else if list := scheduler.List(); len(list) != 1 || !list[0].Paused {
+			t.Errorf("returned the (%v) job list", list)
+		}
+	})
+}
+
+func Test_TriggerScheduler_Resume(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var scheduler *TriggerScheduler
+		_ = scheduler.Resume("job")
+	})
+
+	t.Run("error on unknown job", func(t *testing.T) {
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		if err := scheduler.Resume("job"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "job 'job' not registered" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("restart a paused job", func(t *testing.T) {
+		check := int32(0)
+
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		_ = scheduler.AddRecurring("job", 10*time.Millisecond, TriggerFailurePolicy{}, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return nil
+		})
+		_ = scheduler.Pause("job")
+		executed := atomic.LoadInt32(&check)
+
+		if err := scheduler.Resume("job"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		} else if err := scheduler.Resume("job"); err != nil {
+			t.Errorf("returned the (%v) error on a running job", err)
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		if atomic.LoadInt32(&check) == executed {
+			t.Error("didn't restarted the job")
+		} else if list := scheduler.List(); len(list) != 1 || list[0].Paused {
+			t.Errorf("returned the (%v) job list", list)
+		}
+	})
+
+	t.Run("restart a job stopped by a callback error", func(t *testing.T) {
+		check := int32(0)
+
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		_ = scheduler.AddRecurring("job", 10*time.Millisecond, TriggerFailurePolicy{}, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return fmt.Errorf("__dummy_error__")
+		})
+
+		time.Sleep(50 * time.Millisecond)
+		if list := scheduler.List(); len(list) != 1 || list[0].Paused || !list[0].Stopped {
+			t.Errorf("returned the (%v) job list", list)
+		} else if c := atomic.LoadInt32(&check); c != 1 {
+			t.Errorf("executed the job (%d) times", c)
+		}
+
+		if err := scheduler.Resume("job"); err != nil {
+			t.Errorf("returned the (%v) error", err)
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		if c := atomic.LoadInt32(&check); c != 2 {
+			t.Errorf("executed the job (%d) times", c)
+		}
+	})
+}
+
+func Test_TriggerScheduler_Run(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var scheduler *TriggerScheduler
+		_ = scheduler.Run(context.Background(), "job")
+	})
+
+	t.Run("nil context", func(t *testing.T) {
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		//nolint:staticcheck
+		if err := scheduler.Run(nil, "job"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "invalid nil 'ctx' argument" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("error on unknown job", func(t *testing.T) {
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		if err := scheduler.Run(context.Background(), "job"); err == nil {
+			t.Error("didn't returned the expected error")
+		} else if err.Error() != "job 'job' not registered" {
+			t.Errorf("returned the (%v) error", err)
+		}
+	})
+
+	t.Run("execute a paused job callback", func(t *testing.T) {
+		expected := fmt.Errorf("__dummy_error__")
+		check := int32(0)
+
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		_ = scheduler.AddCron("job", "@yearly", time.UTC, TriggerFailurePolicy{}, func(context.Context) error {
+			atomic.AddInt32(&check, 1)
+			return expected
+		})
+		_ = scheduler.Pause("job")
+
+		if err := scheduler.Run(context.Background(), "job"); err != expected {
+			t.Errorf("returned the (%v) error", err)
+		} else if atomic.LoadInt32(&check) != 1 {
+			t.Error("didn't executed the job callback")
+		} else if list := scheduler.List(); list[0].LastRun.IsZero() {
+			t.Error("didn't stored the job last execution time")
+		}
+	})
+}
+
+func Test_TriggerScheduler_List(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var scheduler *TriggerScheduler
+		_ = scheduler.List()
+	})
+
+	t.Run("retrieve the jobs sorted by name", func(t *testing.T) {
+		callback := func(context.Context) error { return nil }
+
+		scheduler := NewTriggerScheduler()
+		defer scheduler.Close()
+
+		_ = scheduler.AddCron("job2", "@yearly", time.UTC, TriggerFailurePolicy{}, callback)
+		_ = scheduler.AddRecurring("job1", 10*time.Millisecond, TriggerFailurePolicy{}, callback)
+		_ = scheduler.AddCron("job3", "@yearly", time.UTC, TriggerFailurePolicy{}, callback)
+		_ = scheduler.Pause("job3")
+
+		time.Sleep(25 * time.Millisecond)
+
+		list := scheduler.List()
+		switch {
+		case len(list) != 3:
+			t.Errorf("returned the (%v) job list", list)
+		case list[0].Name != "job1" || list[1].Name != "job2" || list[2].Name != "job3":
+			t.Errorf("returned the (%v) job list", list)
+		case list[0].LastRun.IsZero():
+			t.Error("didn't retrieved the executed job last run time")
+		case !list[1].LastRun.IsZero():
+			t.Error("retrieved a last run time of a not executed job")
+		case list[1].NextRun.IsZero() || list[1].NextRun.Before(time.Now()):
+			t.Errorf("retrieved the (%v) next run time of the cron job", list[1].NextRun)
+		case list[0].Stopped || list[1].Stopped:
+			t.Error("flagged a running job as stopped")
+		case !list[2].Paused || !list[2].NextRun.IsZero():
+			t.Errorf("retrieved the (%v) paused job information", list[2])
+		}
+	})
+}
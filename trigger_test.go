@@ -1,7 +1,11 @@
 package servlet
 
 import (
+	"context"
+	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func Test_Trigger_Close(t *testing.T) {
@@ -47,3 +51,181 @@ func Test_Trigger_Stop(t *testing.T) {
 		trigger.Stop()
 	})
 }
+
+func Test_Trigger_Done(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var trigger *Trigger
+		_ = trigger.Done()
+	})
+
+	t.Run("close the channel when the execution terminates", func(t *testing.T) {
+		trigger, _ := NewTriggerPulse(10*time.Millisecond, func(context.Context) error {
+			return nil
+		})
+		defer trigger.Close()
+
+		select {
+		case <-trigger.Done():
+			if !trigger.IsStopped() {
+				t.Error("didn't flagged the trigger as stopped")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Error("didn't closed the done channel")
+		}
+	})
+
+	t.Run("close the channel when stopped", func(t *testing.T) {
+		trigger, _ := NewTriggerRecurring(10*time.Millisecond, func(context.Context) error {
+			return nil
+		})
+		trigger.Stop()
+
+		select {
+		case <-trigger.Done():
+		case <-time.After(100 * time.Millisecond):
+			t.Error("didn't closed the done channel")
+		}
+	})
+}
+
+func Test_Trigger_Wait(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var trigger *Trigger
+		trigger.Wait()
+	})
+
+	t.Run("wait for the running callback to terminate", func(t *testing.T) {
+		var finished int32
+
+		trigger, _ := NewTriggerRecurring(10*time.Millisecond, func(ctx context.Context) error {
+			<-ctx.Done()
+			time.Sleep(20 * time.Millisecond)
+			atomic.StoreInt32(&finished, 1)
+			return nil
+		})
+
+		time.Sleep(20 * time.Millisecond)
+		trigger.Stop()
+		trigger.Wait()
+
+		if atomic.LoadInt32(&finished) != 1 {
+			t.Error("didn't waited for the callback to terminate")
+		}
+	})
+}
+
+func Test_Trigger_Context(t *testing.T) {
+	t.Run("cancel the callback context when stopped", func(t *testing.T) {
+		started := make(chan struct{})
+		cancelled := make(chan error, 1)
+
+		trigger, _ := NewTriggerPulse(0, func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			cancelled <- ctx.Err()
+			return nil
+		})
+
+		<-started
+		trigger.Stop()
+
+		select {
+		case err := <-cancelled:
+			if err != context.Canceled {
+				t.Errorf("cancelled the context with the (%v) error", err)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Error("didn't cancelled the callback context")
+		}
+	})
+}
+
+func Test_Trigger_SetErrorHandler(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var trigger *Trigger
+		trigger.SetErrorHandler(func(error) {})
+	})
+
+	t.Run("call the handler with the callback error", func(t *testing.T) {
+		expected := fmt.Errorf("error")
+		errs := make(chan error, 1)
+
+		trigger, _ := NewTriggerRecurring(10*time.Millisecond, func(context.Context) error {
+			return expected
+		})
+		trigger.SetErrorHandler(func(err error) {
+			errs <- err
+		})
+
+		select {
+		case err := <-errs:
+			if err != expected {
+				t.Errorf("called the handler with the (%v) error", err)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Error("didn't called the error handler")
+		}
+
+		trigger.Wait()
+		if !trigger.IsStopped() {
+			t.Error("didn't stopped the trigger")
+		}
+	})
+}
+
+func Test_Trigger_NextRun(t *testing.T) {
+	t.Run("nil pointer receiver", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("didn't panic")
+			}
+		}()
+
+		var trigger *Trigger
+		_ = trigger.NextRun()
+	})
+
+	t.Run("retrieve the scheduled execution time", func(t *testing.T) {
+		start := time.Now()
+
+		trigger, _ := NewTriggerPulse(50*time.Millisecond, func(context.Context) error {
+			return nil
+		})
+		defer trigger.Close()
+
+		time.Sleep(10 * time.Millisecond)
+		if next := trigger.NextRun(); next.Before(start.Add(50*time.Millisecond)) || next.After(time.Now().Add(50*time.Millisecond)) {
+			t.Errorf("returned the (%v) time", next)
+		}
+	})
+
+	t.Run("retrieve the zero time on a stopped trigger", func(t *testing.T) {
+		trigger, _ := NewTriggerRecurring(20*time.Millisecond, func(context.Context) error {
+			return nil
+		})
+		trigger.Stop()
+		trigger.Wait()
+
+		if next := trigger.NextRun(); !next.IsZero() {
+			t.Errorf("returned the (%v) time", next)
+		}
+	})
+}